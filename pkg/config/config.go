@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
@@ -11,6 +13,10 @@ type App struct {
 	JWTSecret       string `envconfig:"JWT_SECRET" required:"true"`
 	JWTExpireMin    int    `envconfig:"JWT_EXPIRE_MIN" default:"60"`
 	RefreshExpireHr int    `envconfig:"REFRESH_EXPIRE_HR" default:"720"`
+	// RabbitMQ (auth-service publish user.* ผ่าน outbox)
+	RabbitURL          string        `envconfig:"RABBIT_URL"`
+	UserExchange       string        `envconfig:"USER_EXCHANGE" default:"user.exchange"`
+	AuthOutboxInterval time.Duration `envconfig:"AUTH_OUTBOX_INTERVAL" default:"1s"`
 	// Network
 	AuthGRPCAddr    string `envconfig:"AUTH_GRPC_ADDR" default:":50051"`
 	CourtGRPCAddr   string `envconfig:"COURT_GRPC_ADDR" default:":50052"`
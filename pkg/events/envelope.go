@@ -0,0 +1,72 @@
+// Package events สัญญา (contract) ของ event ทุกตัวที่วิ่งผ่าน RabbitMQ
+// ทุก event ถูกห่อด้วย Envelope เดียวกัน; routing key = Envelope.Type
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Version เวอร์ชันของ payload ที่ package นี้ผลิตและอ่านได้
+// เปลี่ยนแบบไม่ backward compatible (ลบ/เปลี่ยนความหมาย field) ต้องขึ้นเวอร์ชันใหม่
+const Version = 1
+
+// ErrUnsupportedVersion envelope มาจาก producer ที่ใหม่/เก่ากว่าที่ consumer รองรับ
+var ErrUnsupportedVersion = errors.New("unsupported event version")
+
+// Envelope ห่อ payload พร้อม metadata กลาง
+type Envelope[T any] struct {
+	ID         string            `json:"id"`   // unique ต่อ event (uuid)
+	Type       string            `json:"type"` // เช่น booking.created
+	Version    int               `json:"version"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Trace      map[string]string `json:"trace,omitempty"` // W3C trace context (traceparent/tracestate)
+	Data       T                 `json:"data"`
+}
+
+// New สร้าง envelope ใหม่ และแนบ trace context จาก ctx (ถ้ามี)
+func New[T any](ctx context.Context, typ string, data T) Envelope[T] {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	env := Envelope[T]{
+		ID:         uuid.NewString(),
+		Type:       typ,
+		Version:    Version,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+	if len(carrier) > 0 {
+		env.Trace = carrier
+	}
+	return env
+}
+
+// Decode อ่าน body เป็น Envelope[T] และตรวจ metadata ขั้นต่ำ
+func Decode[T any](body []byte) (Envelope[T], error) {
+	var env Envelope[T]
+	if err := json.Unmarshal(body, &env); err != nil {
+		return env, fmt.Errorf("decode event: %w", err)
+	}
+	if env.Version != Version {
+		return env, fmt.Errorf("%w: %s v%d", ErrUnsupportedVersion, env.Type, env.Version)
+	}
+	if env.ID == "" || env.Type == "" {
+		return env, errors.New("decode event: missing id or type")
+	}
+	return env, nil
+}
+
+// Context คืน ctx ที่ต่อ trace จาก producer
+func (e Envelope[T]) Context(ctx context.Context) context.Context {
+	if len(e.Trace) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(e.Trace))
+}
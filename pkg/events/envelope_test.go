@@ -0,0 +1,120 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// roundTrip encode ด้วย New แบบ producer แล้ว decode ด้วย Decode[T] แบบ consumer
+func roundTrip[T any](t *testing.T, typ string, data T) {
+	t.Helper()
+	body, err := json.Marshal(New(context.Background(), typ, data))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	got, err := Decode[T](body)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.Type != typ || got.Version != Version || got.ID == "" || got.OccurredAt.IsZero() {
+		t.Fatalf("bad envelope metadata: %+v", got)
+	}
+	if !reflect.DeepEqual(got.Data, data) {
+		t.Fatalf("data mismatch\n got: %+v\nwant: %+v", got.Data, data)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	refunds := []Refund{{PaymentID: "chrg_1", Amount: 15000}, {PaymentID: "chrg_2", Amount: 5000}}
+	tests := []struct {
+		key string
+		run func(t *testing.T, key string)
+	}{
+		{RKBookingCreated, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingCreated{BookingID: "b1", UserID: "u1", CourtID: "c1", Start: 1700000000, End: 1700003600, SeriesID: "s1", Amount: 20000, Currency: "thb"})
+		}},
+		{RKBookingConfirmed, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingStatus{BookingID: "b1", UserID: "u1", CourtID: "c1"})
+		}},
+		{RKBookingExpired, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingStatus{BookingID: "b1", UserID: "u1", CourtID: "c1", Refunds: refunds, Currency: "thb"})
+		}},
+		{RKBookingCancelled, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingCancelled{BookingID: "b1", UserID: "u1", CourtID: "c1", SeriesID: "s1", Reason: "by user", PaymentID: "chrg_1", RefundAmount: 10000, Currency: "thb"})
+		}},
+		{RKBookingCancelled + " (split)", func(t *testing.T, _ string) {
+			roundTrip(t, RKBookingCancelled, BookingCancelled{BookingID: "b1", UserID: "u1", CourtID: "c1", Refunds: refunds, Currency: "thb"})
+		}},
+		{RKBookingRescheduled, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingRescheduled{BookingID: "b1", UserID: "u1", OldCourtID: "c1", OldStart: 1, OldEnd: 2, CourtID: "c2", Start: 3, End: 4})
+		}},
+		{RKBookingSplit, func(t *testing.T, k string) {
+			roundTrip(t, k, BookingSplit{BookingID: "b1", UserID: "u1", CourtID: "c1", Start: 1, End: 2, ExpiresAt: 3, Currency: "thb",
+				Shares: []ShareInvite{{ShareID: "sh1", UserID: "u2", Amount: 10000}, {ShareID: "sh2", Email: "a@b.c", Amount: 10000}}})
+		}},
+		{RKBookingPaymentRejected, func(t *testing.T, k string) {
+			roundTrip(t, k, PaymentRejected{BookingID: "b1", UserID: "u1", PaymentID: "chrg_1", ShareID: "sh1", Amount: 10000, Currency: "thb", Reason: "booking is CANCELLED"})
+		}},
+		{RKPaymentPaid, func(t *testing.T, k string) {
+			roundTrip(t, k, PaymentPaid{PaymentID: "chrg_1", BookingID: "b1", Amount: 20000, Currency: "thb", Method: "promptpay", ShareID: "sh1"})
+		}},
+		{RKPaymentFailed, func(t *testing.T, k string) {
+			roundTrip(t, k, PaymentFailed{PaymentID: "chrg_1", BookingID: "b1", FailureCode: "insufficient_fund", FailureMessage: "no money"})
+		}},
+		{RKPaymentRefunded, func(t *testing.T, k string) {
+			roundTrip(t, k, PaymentRefunded{RefundID: "rfnd_1", PaymentID: "chrg_1", BookingID: "b1", Amount: 20000, Currency: "thb", Reason: "cancelled"})
+		}},
+		{RKUserRegistered, func(t *testing.T, k string) {
+			roundTrip(t, k, UserRegistered{UserID: "u1", Email: "a@b.c", Role: "USER"})
+		}},
+		{RKUserRoleChanged, func(t *testing.T, k string) {
+			roundTrip(t, k, UserRoleChanged{UserID: "u1", Email: "a@b.c", Name: "A", OldRole: "USER", NewRole: "OWNER", ChangedBy: "admin", Reason: "approved"})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) { tt.run(t, tt.key) })
+	}
+}
+
+// TestDecodeWireFormat ล็อกชื่อ field บนสาย: producer เปลี่ยน json tag แล้ว test นี้ต้องพัง
+func TestDecodeWireFormat(t *testing.T) {
+	body := []byte(`{"id":"e1","type":"payment.paid","version":1,"occurred_at":"2025-01-01T00:00:00Z",
+		"data":{"payment_id":"chrg_1","booking_id":"b1","amount":20000,"currency":"thb","method":"card","share_id":"sh1"}}`)
+	ev, err := Decode[PaymentPaid](body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := PaymentPaid{PaymentID: "chrg_1", BookingID: "b1", Amount: 20000, Currency: "thb", Method: "card", ShareID: "sh1"}
+	if ev.Data != want {
+		t.Fatalf("got %+v, want %+v", ev.Data, want)
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{"not json", `{`, nil},
+		{"other version", `{"id":"e1","type":"payment.paid","version":2,"data":{}}`, ErrUnsupportedVersion},
+		{"missing version", `{"id":"e1","type":"payment.paid","data":{}}`, ErrUnsupportedVersion},
+		{"missing id", `{"type":"payment.paid","version":1,"data":{}}`, nil},
+		{"missing type", `{"id":"e1","version":1,"data":{}}`, nil},
+		{"wrong data shape", `{"id":"e1","type":"payment.paid","version":1,"data":{"amount":"100"}}`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode[PaymentPaid]([]byte(tt.body))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package events
+
+// routing keys (= Envelope.Type)
+const (
+	RKBookingCreated     = "booking.created"
+	RKBookingConfirmed   = "booking.confirmed"
+	RKBookingCancelled   = "booking.cancelled"
+	RKBookingExpired     = "booking.expired"
+	RKBookingRescheduled = "booking.rescheduled"
+	RKBookingCompleted   = "booking.completed"
+	RKBookingNoShow      = "booking.no_show"
+	RKBookingSplit       = "booking.split"
+	// RKBookingPaymentRejected เงินเข้ามาแต่ booking รับไม่ได้แล้ว: payment-service ต้องคืนเต็มจำนวน
+	RKBookingPaymentRejected = "booking.payment_rejected"
+
+	RKPaymentPaid     = "payment.paid"
+	RKPaymentFailed   = "payment.failed"
+	RKPaymentRefunded = "payment.refunded"
+
+	RKUserRegistered  = "user.registered"
+	RKUserRoleChanged = "user.role_changed"
+)
+
+// ---------- booking.* (producer: booking-service ผ่าน outbox) ----------
+
+// BookingCreated พกข้อมูลให้พอสำหรับข้อความแจ้งเตือน
+type BookingCreated struct {
+	BookingID string `json:"booking_id"`
+	UserID    string `json:"user_id"`
+	CourtID   string `json:"court_id"`
+	Start     int64  `json:"start"` // unix seconds
+	End       int64  `json:"end"`
+	SeriesID  string `json:"series_id,omitempty"`
+	Amount    int64  `json:"amount"` // สตางค์
+	Currency  string `json:"currency"`
+}
+
+// BookingStatus ใช้กับ booking.confirmed / booking.expired / booking.completed / booking.no_show
+type BookingStatus struct {
+	BookingID string `json:"booking_id"`
+	UserID    string `json:"user_id"`
+	CourtID   string `json:"court_id"`
+	// booking.expired ของ booking หารจ่ายที่จ่ายมาแล้วบางส่วน: คืนเต็มทุกส่วนที่จ่าย
+	Refunds  []Refund `json:"refunds,omitempty"`
+	Currency string   `json:"currency,omitempty"`
+}
+
+// Refund ยอดที่ payment-service ต้องคืนเข้า charge หนึ่ง (booking หารจ่ายมีหลาย charge)
+type Refund struct {
+	PaymentID string `json:"payment_id"`
+	Amount    int64  `json:"amount"` // สตางค์
+}
+
+type BookingCancelled struct {
+	BookingID string `json:"booking_id"`
+	UserID    string `json:"user_id"`
+	CourtID   string `json:"court_id"`
+	SeriesID  string `json:"series_id,omitempty"`
+	Reason    string `json:"reason,omitempty"` // เช่น payment failed: insufficient_fund
+	// ยอดคืนตาม cancellation policy (0 = ไม่คืน); payment-service เป็นคนคืนเงินเข้า PaymentID
+	PaymentID    string `json:"payment_id,omitempty"`
+	RefundAmount int64  `json:"refund_amount,omitempty"` // สตางค์
+	Currency     string `json:"currency,omitempty"`
+	// booking หารจ่าย: คืนแยกตาม charge ของแต่ละส่วน (ใช้แทน PaymentID/RefundAmount)
+	Refunds []Refund `json:"refunds,omitempty"`
+}
+
+// BookingSplit ผู้จองแบ่งค่าสนามเป็นหลายส่วน; ผู้ถูกเชิญแต่ละคนจ่ายส่วนของตัวเอง (share_id)
+type BookingSplit struct {
+	BookingID string        `json:"booking_id"`
+	UserID    string        `json:"user_id"` // ผู้จัด (เจ้าของ booking)
+	CourtID   string        `json:"court_id"`
+	Start     int64         `json:"start"` // unix seconds
+	End       int64         `json:"end"`
+	ExpiresAt int64         `json:"expires_at"` // ต้องจ่ายครบก่อนเวลานี้
+	Currency  string        `json:"currency"`
+	Shares    []ShareInvite `json:"shares"`
+}
+
+type ShareInvite struct {
+	ShareID string `json:"share_id"`
+	UserID  string `json:"user_id,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Amount  int64  `json:"amount"` // สตางค์
+}
+
+// BookingRescheduled เวลาเดิม/ใหม่ของ booking ที่ถูกย้าย
+type BookingRescheduled struct {
+	BookingID  string `json:"booking_id"`
+	UserID     string `json:"user_id"`
+	OldCourtID string `json:"old_court_id"`
+	OldStart   int64  `json:"old_start"` // unix seconds
+	OldEnd     int64  `json:"old_end"`
+	CourtID    string `json:"court_id"`
+	Start      int64  `json:"start"`
+	End        int64  `json:"end"`
+}
+
+// PaymentRejected charge ที่สำเร็จแล้วแต่ booking-service ไม่นำไปใช้ (booking ถูกยกเลิก/หมดเวลา/hold หมด)
+// payment-service คืนเงิน Amount เข้า PaymentID (กันซ้ำด้วย PaymentID)
+type PaymentRejected struct {
+	BookingID string `json:"booking_id"`
+	UserID    string `json:"user_id"`
+	PaymentID string `json:"payment_id"`
+	ShareID   string `json:"share_id,omitempty"`
+	Amount    int64  `json:"amount"` // สตางค์
+	Currency  string `json:"currency"`
+	Reason    string `json:"reason"`
+}
+
+// ---------- payment.* (producer: payment-service ทั้งตอนสร้าง charge และจาก webhook) ----------
+// charge เดียวอาจถูกรายงานได้มากกว่าหนึ่งครั้ง consumer ต้องกันซ้ำด้วย PaymentID ไม่ใช่ Envelope.ID
+
+type PaymentPaid struct {
+	PaymentID string `json:"payment_id"` // Omise charge id
+	BookingID string `json:"booking_id"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+	Method    string `json:"method"`             // card หรือ source type เช่น promptpay
+	ShareID   string `json:"share_id,omitempty"` // จ่ายเฉพาะส่วนหนึ่งของ booking หารจ่าย
+}
+
+type PaymentFailed struct {
+	PaymentID      string `json:"payment_id"` // ว่างได้ถ้ายังสร้าง charge ไม่สำเร็จ
+	BookingID      string `json:"booking_id"`
+	FailureCode    string `json:"failure_code,omitempty"`
+	FailureMessage string `json:"failure_message,omitempty"`
+	ShareID        string `json:"share_id,omitempty"`
+}
+
+// PaymentRefunded คืนเงิน (บางส่วนหรือทั้งหมด) ของ charge หนึ่ง
+type PaymentRefunded struct {
+	RefundID  string `json:"refund_id"`
+	PaymentID string `json:"payment_id"`
+	BookingID string `json:"booking_id"`
+	Amount    int64  `json:"amount"` // สตางค์
+	Currency  string `json:"currency"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// ---------- user.* (producer: auth-service ผ่าน outbox; user.registered ยังไม่มีตัว publish) ----------
+
+type UserRegistered struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+}
+
+// UserRoleChanged role ใน auth เปลี่ยน (ADMIN กำหนด / อนุมัติคำขอเป็น OWNER)
+// user-service จับคู่ด้วย Email (id ของสอง service ไม่ใช่ตัวเดียวกัน)
+type UserRoleChanged struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Name      string `json:"name,omitempty"`
+	OldRole   string `json:"old_role"`
+	NewRole   string `json:"new_role"`
+	ChangedBy string `json:"changed_by"` // user id ของ ADMIN
+	Reason    string `json:"reason,omitempty"`
+}
@@ -4,32 +4,53 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// Publisher publish แบบ confirm mode: PublishJSON คืน nil เมื่อ broker ack แล้วเท่านั้น
+// connection/channel ที่หลุดจะถูกเปิดใหม่ตอน publish ครั้งถัดไป
 type Publisher struct {
-	conn     *amqp.Connection
-	ch       *amqp.Channel
+	url      string
 	exchange string
+
+	mu   sync.Mutex // หนึ่ง channel ใช้ publish + รอ confirm ทีละข้อความ
+	conn *amqp.Connection
+	ch   *amqp.Channel
 }
 
 func NewPublisher(url, exchange string) (*Publisher, error) {
-	conn, err := amqp.Dial(url)
+	p := &Publisher{url: url, exchange: exchange}
+	if err := p.connect(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// connect เปิด connection + channel ใหม่ (เรียกภายใต้ mu หรือก่อนแชร์ Publisher)
+func (p *Publisher) connect() error {
+	conn, err := amqp.Dial(p.url)
 	if err != nil {
-		return nil, fmt.Errorf("dial rabbitmq: %w", err)
+		return fmt.Errorf("dial rabbitmq: %w", err)
 	}
 	ch, err := conn.Channel()
 	if err != nil {
 		_ = conn.Close()
-		return nil, fmt.Errorf("open channel: %w", err)
+		return fmt.Errorf("open channel: %w", err)
+	}
+	if err := ch.Confirm(false); err != nil {
+		_ = ch.Close()
+		_ = conn.Close()
+		return fmt.Errorf("enable publisher confirms: %w", err)
 	}
-	if err := ch.ExchangeDeclare(exchange, "topic", true, false, false, false, nil); err != nil {
+	if err := ch.ExchangeDeclare(p.exchange, "topic", true, false, false, false, nil); err != nil {
 		_ = ch.Close()
 		_ = conn.Close()
-		return nil, fmt.Errorf("declare exchange: %w", err)
+		return fmt.Errorf("declare exchange: %w", err)
 	}
-	return &Publisher{conn: conn, ch: ch, exchange: exchange}, nil
+	p.conn, p.ch = conn, ch
+	return nil
 }
 
 func (p *Publisher) PublishJSON(ctx context.Context, key string, v any) error {
@@ -37,18 +58,48 @@ func (p *Publisher) PublishJSON(ctx context.Context, key string, v any) error {
 	if err != nil {
 		return err
 	}
-	return p.ch.PublishWithContext(ctx, p.exchange, key, false, false, amqp.Publishing{
-		ContentType: "application/json",
-		Body:        b,
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ch == nil || p.ch.IsClosed() {
+		p.closeLocked()
+		if err := p.connect(); err != nil {
+			return fmt.Errorf("reconnect: %w", err)
+		}
+	}
+	conf, err := p.ch.PublishWithDeferredConfirmWithContext(ctx, p.exchange, key, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Body:         b,
 	})
+	if err != nil {
+		p.closeLocked() // เปิดใหม่รอบหน้า
+		return fmt.Errorf("publish %s: %w", key, err)
+	}
+	acked, err := conf.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("publish %s: wait for confirm: %w", key, err)
+	}
+	if !acked {
+		return fmt.Errorf("publish %s: not confirmed by broker", key)
+	}
+	return nil
 }
 
-func (p *Publisher) Close() error {
+func (p *Publisher) closeLocked() {
 	if p.ch != nil {
 		_ = p.ch.Close()
+		p.ch = nil
 	}
 	if p.conn != nil {
-		return p.conn.Close()
+		_ = p.conn.Close()
+		p.conn = nil
 	}
+}
+
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closeLocked()
 	return nil
 }
@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Event สิ่งที่จะถูก publish (routing key + payload ที่ marshal เป็น JSON ได้)
+type Event struct {
+	Key     string
+	Payload any
+}
+
+// Message แถวใน outbox_messages: เขียนใน txn เดียวกับการเปลี่ยนข้อมูล แล้ว Relay ค่อย publish ทีหลัง
+type Message struct {
+	ID         uint64 `gorm:"primaryKey"`
+	RoutingKey string
+	Payload    string     `gorm:"type:jsonb"`
+	SentAt     *time.Time `gorm:"index"` // nil = ยังไม่ได้ส่ง
+	Attempts   int
+	LastError  string
+	CreatedAt  time.Time
+}
+
+func (Message) TableName() string { return "outbox_messages" }
+
+// Migrate สร้างตาราง outbox_messages (เรียกจาก Migrate ของ service ที่ใช้ outbox)
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&Message{})
+}
+
+// Enqueue เขียน event ลง outbox ด้วย tx ของผู้เรียก (commit/rollback ไปพร้อมข้อมูลหลัก)
+func Enqueue(tx *gorm.DB, evs ...Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	msgs := make([]Message, 0, len(evs))
+	for _, ev := range evs {
+		b, err := json.Marshal(ev.Payload)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, Message{RoutingKey: ev.Key, Payload: string(b)})
+	}
+	return tx.Create(&msgs).Error
+}
+
+// Publisher ปลายทางของ Relay (เช่น *mq.Publisher)
+type Publisher interface {
+	PublishJSON(ctx context.Context, key string, v any) error
+}
+
+// Relay วน publish แถวที่ยังไม่ส่งตามลำดับ id แล้ว mark sent_at (at-least-once: consumer ต้องกันซ้ำเอง)
+type Relay struct {
+	db        *gorm.DB
+	pub       Publisher
+	interval  time.Duration
+	batchSize int
+	// Retention แถวที่ส่งแล้วเก่ากว่านี้จะถูกลบ (0 = ไม่ลบ)
+	Retention time.Duration
+}
+
+func NewRelay(db *gorm.DB, pub Publisher, interval time.Duration) *Relay {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &Relay{db: db, pub: pub, interval: interval, batchSize: 100, Retention: 7 * 24 * time.Hour}
+}
+
+// Run วนทุก interval จนกว่า ctx จะถูก cancel (เรียกใน goroutine)
+func (r *Relay) Run(ctx context.Context) {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+	for {
+		for {
+			n, err := r.Flush(ctx)
+			if err != nil {
+				log.Printf("[outbox] relay error: %v", err)
+				break
+			}
+			if n < r.batchSize {
+				break
+			}
+		}
+		r.prune(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Flush ส่งแถวที่ค้างหนึ่ง batch คืนจำนวนที่ส่งสำเร็จ
+// แถวถูก mark sent หลัง broker ยืนยัน (Publisher ต้องรอ confirm) เท่านั้น
+// หยุดที่แถวแรกที่ส่งไม่ได้เพื่อคงลำดับ: บันทึก attempts/last_error แล้วคืน error นั้น (รอบหน้าจะลองใหม่)
+// SKIP LOCKED ทำให้รันหลาย instance พร้อมกันได้
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	sent := 0
+	var pubErr error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []Message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL").
+			Order("id ASC").
+			Limit(r.batchSize).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if err := r.pub.PublishJSON(ctx, m.RoutingKey, json.RawMessage(m.Payload)); err != nil {
+				pubErr = fmt.Errorf("publish outbox message %d (%s): %w", m.ID, m.RoutingKey, err)
+				// commit แถวที่ส่งแล้วใน batch นี้พร้อมจำนวนครั้งที่ล้มเหลว
+				return tx.Model(&Message{}).Where("id = ?", m.ID).Updates(map[string]any{
+					"attempts":   gorm.Expr("attempts + 1"),
+					"last_error": err.Error(),
+				}).Error
+			}
+			now := time.Now().UTC()
+			if err := tx.Model(&Message{}).Where("id = ?", m.ID).Update("sent_at", now).Error; err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return sent, err
+	}
+	return sent, pubErr
+}
+
+func (r *Relay) prune(ctx context.Context) {
+	if r.Retention <= 0 {
+		return
+	}
+	cutoff := time.Now().UTC().Add(-r.Retention)
+	if err := r.db.WithContext(ctx).Where("sent_at < ?", cutoff).Delete(&Message{}).Error; err != nil {
+		log.Printf("[outbox] prune error: %v", err)
+	}
+}
@@ -28,6 +28,9 @@ const (
 	BookingStatus_PENDING                    BookingStatus = 1
 	BookingStatus_CONFIRMED                  BookingStatus = 2
 	BookingStatus_CANCELLED                  BookingStatus = 3
+	BookingStatus_EXPIRED                    BookingStatus = 4 // PENDING hold หมดเวลาโดยไม่ได้ชำระ
+	BookingStatus_COMPLETED                  BookingStatus = 5 // มาเล่นตามเวลา (เจ้าของสนามปิดงาน)
+	BookingStatus_NO_SHOW                    BookingStatus = 6 // ไม่มาตามเวลา
 )
 
 // Enum value maps for BookingStatus.
@@ -37,12 +40,18 @@ var (
 		1: "PENDING",
 		2: "CONFIRMED",
 		3: "CANCELLED",
+		4: "EXPIRED",
+		5: "COMPLETED",
+		6: "NO_SHOW",
 	}
 	BookingStatus_value = map[string]int32{
 		"BOOKING_STATUS_UNSPECIFIED": 0,
 		"PENDING":                    1,
 		"CONFIRMED":                  2,
 		"CANCELLED":                  3,
+		"EXPIRED":                    4,
+		"COMPLETED":                  5,
+		"NO_SHOW":                    6,
 	}
 )
 
@@ -73,6 +82,55 @@ func (BookingStatus) EnumDescriptor() ([]byte, []int) {
 	return file_booking_v1_booking_proto_rawDescGZIP(), []int{0}
 }
 
+type ConflictMode int32
+
+const (
+	ConflictMode_CONFLICT_MODE_UNSPECIFIED ConflictMode = 0 // = ALL_OR_NOTHING
+	ConflictMode_ALL_OR_NOTHING            ConflictMode = 1
+	ConflictMode_SKIP_CONFLICTS            ConflictMode = 2
+)
+
+// Enum value maps for ConflictMode.
+var (
+	ConflictMode_name = map[int32]string{
+		0: "CONFLICT_MODE_UNSPECIFIED",
+		1: "ALL_OR_NOTHING",
+		2: "SKIP_CONFLICTS",
+	}
+	ConflictMode_value = map[string]int32{
+		"CONFLICT_MODE_UNSPECIFIED": 0,
+		"ALL_OR_NOTHING":            1,
+		"SKIP_CONFLICTS":            2,
+	}
+)
+
+func (x ConflictMode) Enum() *ConflictMode {
+	p := new(ConflictMode)
+	*p = x
+	return p
+}
+
+func (x ConflictMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_proto_enumTypes[1].Descriptor()
+}
+
+func (ConflictMode) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_proto_enumTypes[1]
+}
+
+func (x ConflictMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictMode.Descriptor instead.
+func (ConflictMode) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{1}
+}
+
 type Booking struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -81,6 +139,13 @@ type Booking struct {
 	StartIso      string                 `protobuf:"bytes,4,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"` // RFC3339 UTC
 	EndIso        string                 `protobuf:"bytes,5,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`       // RFC3339 UTC
 	Status        BookingStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	ExpiresAtIso  string                 `protobuf:"bytes,7,opt,name=expires_at_iso,json=expiresAtIso,proto3" json:"expires_at_iso,omitempty"` // RFC3339 UTC; เวลาที่ hold ของ PENDING หมดอายุ
+	Amount        int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`                                  // ราคาที่ server คำนวณ (หน่วยย่อย เช่น สตางค์)
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,10,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`    // ว่าง = booking เดี่ยว
+	PaymentId     string                 `protobuf:"bytes,11,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // charge ที่ทำให้ CONFIRMED (ว่าง = ยังไม่จ่าย)
+	PromoCode     string                 `protobuf:"bytes,12,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"` // โค้ดส่วนลดที่ใช้ (ว่าง = ไม่มี)
+	Discount      int64                  `protobuf:"varint,13,opt,name=discount,proto3" json:"discount,omitempty"`                   // ส่วนลดที่หักจาก amount แล้ว (สตางค์)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,12 +222,62 @@ func (x *Booking) GetStatus() BookingStatus {
 	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
 }
 
+func (x *Booking) GetExpiresAtIso() string {
+	if x != nil {
+		return x.ExpiresAtIso
+	}
+	return ""
+}
+
+func (x *Booking) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Booking) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Booking) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Booking) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Booking) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Booking) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
 type CreateBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Gateway should populate from JWT
 	CourtId       string                 `protobuf:"bytes,2,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
-	StartIso      string                 `protobuf:"bytes,3,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"` // RFC3339
-	EndIso        string                 `protobuf:"bytes,4,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`       // RFC3339
+	StartIso      string                 `protobuf:"bytes,3,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"`    // RFC3339
+	EndIso        string                 `protobuf:"bytes,4,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`          // RFC3339
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"` // optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -225,6 +340,13 @@ func (x *CreateBookingRequest) GetEndIso() string {
 	return ""
 }
 
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
 type CreateBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -316,6 +438,9 @@ func (x *GetBookingRequest) GetId() string {
 type GetBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Shares        []*PaymentShare        `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`                            // ว่าง = ไม่ได้หารจ่าย
+	PaidAmount    int64                  `protobuf:"varint,3,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"` // ยอดที่จ่ายแล้วรวมทุกส่วน (สตางค์)
+	Remaining     int64                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`                     // ยอดที่ยังรอจ่าย (สตางค์)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -357,6 +482,27 @@ func (x *GetBookingResponse) GetBooking() *Booking {
 	return nil
 }
 
+func (x *GetBookingResponse) GetShares() []*PaymentShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
 type ListBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 0-based
@@ -576,6 +722,7 @@ func (x *ConfirmBookingResponse) GetBooking() *Booking {
 type CancelBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -617,6 +764,13 @@ func (x *CancelBookingRequest) GetId() string {
 	return ""
 }
 
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type CancelBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -661,59 +815,2198 @@ func (x *CancelBookingResponse) GetBooking() *Booking {
 	return nil
 }
 
-var File_booking_v1_booking_proto protoreflect.FileDescriptor
+// ย้ายเวลา (และสนามถ้าระบุ) ของ booking เดิม; id ไม่เปลี่ยน
+type RescheduleBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewStartIso   string                 `protobuf:"bytes,2,opt,name=new_start_iso,json=newStartIso,proto3" json:"new_start_iso,omitempty"` // RFC3339
+	NewEndIso     string                 `protobuf:"bytes,3,opt,name=new_end_iso,json=newEndIso,proto3" json:"new_end_iso,omitempty"`       // RFC3339
+	NewCourtId    string                 `protobuf:"bytes,4,opt,name=new_court_id,json=newCourtId,proto3" json:"new_court_id,omitempty"`    // optional; ว่าง = สนามเดิม
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_booking_v1_booking_proto_rawDesc = "" +
-	"\n" +
-	"\x18booking/v1/booking.proto\x12\n" +
-	"booking.v1\"\xb6\x01\n" +
-	"\aBooking\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
-	"\bcourt_id\x18\x03 \x01(\tR\acourtId\x12\x1b\n" +
-	"\tstart_iso\x18\x04 \x01(\tR\bstartIso\x12\x17\n" +
-	"\aend_iso\x18\x05 \x01(\tR\x06endIso\x121\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\"\x80\x01\n" +
-	"\x14CreateBookingRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bcourt_id\x18\x02 \x01(\tR\acourtId\x12\x1b\n" +
-	"\tstart_iso\x18\x03 \x01(\tR\bstartIso\x12\x17\n" +
-	"\aend_iso\x18\x04 \x01(\tR\x06endIso\"F\n" +
-	"\x15CreateBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"#\n" +
-	"\x11GetBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
-	"\x12GetBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"\x92\x01\n" +
-	"\x12ListBookingRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
-	"\bcourt_id\x18\x04 \x01(\tR\acourtId\x12\x17\n" +
-	"\aday_iso\x18\x05 \x01(\tR\x06dayIso\"\\\n" +
-	"\x13ListBookingResponse\x12/\n" +
-	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"'\n" +
-	"\x15ConfirmBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
-	"\x16ConfirmBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"&\n" +
-	"\x14CancelBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
-	"\x15CancelBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking*Z\n" +
+func (x *RescheduleBookingRequest) Reset() {
+	*x = RescheduleBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingRequest) ProtoMessage() {}
+
+func (x *RescheduleBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RescheduleBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetNewStartIso() string {
+	if x != nil {
+		return x.NewStartIso
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetNewEndIso() string {
+	if x != nil {
+		return x.NewEndIso
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetNewCourtId() string {
+	if x != nil {
+		return x.NewCourtId
+	}
+	return ""
+}
+
+type RescheduleBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleBookingResponse) Reset() {
+	*x = RescheduleBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingResponse) ProtoMessage() {}
+
+func (x *RescheduleBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RescheduleBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type TimeSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartIso      string                 `protobuf:"bytes,1,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"` // RFC3339 UTC
+	EndIso        string                 `protobuf:"bytes,2,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`       // RFC3339 UTC
+	Free          bool                   `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSlot) Reset() {
+	*x = TimeSlot{}
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlot) ProtoMessage() {}
+
+func (x *TimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimeSlot) GetStartIso() string {
+	if x != nil {
+		return x.StartIso
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetEndIso() string {
+	if x != nil {
+		return x.EndIso
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetFree() bool {
+	if x != nil {
+		return x.Free
+	}
+	return false
+}
+
+type GetAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourtId       string                 `protobuf:"bytes,1,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (venue local date)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityRequest) Reset() {
+	*x = GetAvailabilityRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAvailabilityRequest) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *GetAvailabilityRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourtId       string                 `protobuf:"bytes,1,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	OpenFrom      string                 `protobuf:"bytes,3,opt,name=open_from,json=openFrom,proto3" json:"open_from,omitempty"` // HH:mm
+	OpenTo        string                 `protobuf:"bytes,4,opt,name=open_to,json=openTo,proto3" json:"open_to,omitempty"`       // HH:mm
+	Slots         []*TimeSlot            `protobuf:"bytes,5,rep,name=slots,proto3" json:"slots,omitempty"`                       // ordered, covers open_from..open_to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityResponse) Reset() {
+	*x = GetAvailabilityResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAvailabilityResponse) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetOpenFrom() string {
+	if x != nil {
+		return x.OpenFrom
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetOpenTo() string {
+	if x != nil {
+		return x.OpenTo
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetSlots() []*TimeSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// QuoteBooking: ส่ง booking_id (ราคาของ booking ที่มีอยู่) หรือ court_id+start/end (ราคาก่อนจอง)
+type QuoteBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CourtId       string                 `protobuf:"bytes,2,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	StartIso      string                 `protobuf:"bytes,3,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"`    // RFC3339
+	EndIso        string                 `protobuf:"bytes,4,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`          // RFC3339
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"` // optional; ใช้กับราคาก่อนจองเท่านั้น
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteBookingRequest) Reset() {
+	*x = QuoteBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteBookingRequest) ProtoMessage() {}
+
+func (x *QuoteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteBookingRequest.ProtoReflect.Descriptor instead.
+func (*QuoteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuoteBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetStartIso() string {
+	if x != nil {
+		return x.StartIso
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetEndIso() string {
+	if x != nil {
+		return x.EndIso
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type QuoteBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"` // หน่วยย่อย (สตางค์) หลังหักส่วนลด
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // จาก court-service (หน่วยหลัก เช่น บาท)
+	Minutes       int32                  `protobuf:"varint,4,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Discount      int64                  `protobuf:"varint,5,opt,name=discount,proto3" json:"discount,omitempty"` // สตางค์
+	PromoCode     string                 `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteBookingResponse) Reset() {
+	*x = QuoteBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteBookingResponse) ProtoMessage() {}
+
+func (x *QuoteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteBookingResponse.ProtoReflect.Descriptor instead.
+func (*QuoteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QuoteBookingResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteBookingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QuoteBookingResponse) GetPricePerHour() int64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+func (x *QuoteBookingResponse) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *QuoteBookingResponse) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *QuoteBookingResponse) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type BookingSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourtId       string                 `protobuf:"bytes,3,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	Rule          string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`     // RRULE เช่น FREQ=WEEKLY;INTERVAL=1;COUNT=12
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // ACTIVE|CANCELLED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingSeries) Reset() {
+	*x = BookingSeries{}
+	mi := &file_booking_v1_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSeries) ProtoMessage() {}
+
+func (x *BookingSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSeries.ProtoReflect.Descriptor instead.
+func (*BookingSeries) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BookingSeries) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ครั้งแรกคือ start_iso/end_iso แล้วซ้ำทุก interval_weeks สัปดาห์ จนถึง until_iso หรือครบ count ครั้ง
+type CreateRecurringBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Gateway should populate from JWT
+	CourtId       string                 `protobuf:"bytes,2,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	StartIso      string                 `protobuf:"bytes,3,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"`                 // RFC3339
+	EndIso        string                 `protobuf:"bytes,4,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`                       // RFC3339
+	IntervalWeeks int32                  `protobuf:"varint,5,opt,name=interval_weeks,json=intervalWeeks,proto3" json:"interval_weeks,omitempty"` // default 1
+	UntilIso      string                 `protobuf:"bytes,6,opt,name=until_iso,json=untilIso,proto3" json:"until_iso,omitempty"`                 // RFC3339 (ใช้อย่างใดอย่างหนึ่งกับ count)
+	Count         int32                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	ConflictMode  ConflictMode           `protobuf:"varint,8,opt,name=conflict_mode,json=conflictMode,proto3,enum=booking.v1.ConflictMode" json:"conflict_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringBookingRequest) Reset() {
+	*x = CreateRecurringBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringBookingRequest) ProtoMessage() {}
+
+func (x *CreateRecurringBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateRecurringBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecurringBookingRequest) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *CreateRecurringBookingRequest) GetStartIso() string {
+	if x != nil {
+		return x.StartIso
+	}
+	return ""
+}
+
+func (x *CreateRecurringBookingRequest) GetEndIso() string {
+	if x != nil {
+		return x.EndIso
+	}
+	return ""
+}
+
+func (x *CreateRecurringBookingRequest) GetIntervalWeeks() int32 {
+	if x != nil {
+		return x.IntervalWeeks
+	}
+	return 0
+}
+
+func (x *CreateRecurringBookingRequest) GetUntilIso() string {
+	if x != nil {
+		return x.UntilIso
+	}
+	return ""
+}
+
+func (x *CreateRecurringBookingRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CreateRecurringBookingRequest) GetConflictMode() ConflictMode {
+	if x != nil {
+		return x.ConflictMode
+	}
+	return ConflictMode_CONFLICT_MODE_UNSPECIFIED
+}
+
+type OccurrenceConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartIso      string                 `protobuf:"bytes,1,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"` // RFC3339 UTC
+	EndIso        string                 `protobuf:"bytes,2,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`       // RFC3339 UTC
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OccurrenceConflict) Reset() {
+	*x = OccurrenceConflict{}
+	mi := &file_booking_v1_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OccurrenceConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccurrenceConflict) ProtoMessage() {}
+
+func (x *OccurrenceConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccurrenceConflict.ProtoReflect.Descriptor instead.
+func (*OccurrenceConflict) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OccurrenceConflict) GetStartIso() string {
+	if x != nil {
+		return x.StartIso
+	}
+	return ""
+}
+
+func (x *OccurrenceConflict) GetEndIso() string {
+	if x != nil {
+		return x.EndIso
+	}
+	return ""
+}
+
+func (x *OccurrenceConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateRecurringBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *BookingSeries         `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"` // ไม่มีค่า = ไม่ได้สร้างอะไรเลย (ดู conflicts)
+	Bookings      []*Booking             `protobuf:"bytes,2,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Conflicts     []*OccurrenceConflict  `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringBookingResponse) Reset() {
+	*x = CreateRecurringBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringBookingResponse) ProtoMessage() {}
+
+func (x *CreateRecurringBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRecurringBookingResponse) GetSeries() *BookingSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CreateRecurringBookingResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *CreateRecurringBookingResponse) GetConflicts() []*OccurrenceConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CancelBookingSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesRequest) Reset() {
+	*x = CancelBookingSeriesRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesRequest) ProtoMessage() {}
+
+func (x *CancelBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelBookingSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CancelBookingSeriesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelBookingSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesResponse) Reset() {
+	*x = CancelBookingSeriesResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesResponse) ProtoMessage() {}
+
+func (x *CancelBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelBookingSeriesResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// ปิด booking ที่ CONFIRMED เป็น COMPLETED หรือ NO_SHOW (เจ้าของสนาม/ADMIN)
+type UpdateBookingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusRequest) Reset() {
+	*x = UpdateBookingStatusRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateBookingStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBookingStatusRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateBookingStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateBookingStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusResponse) Reset() {
+	*x = UpdateBookingStatusResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusResponse) ProtoMessage() {}
+
+func (x *UpdateBookingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateBookingStatusResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// ประวัติการเปลี่ยนสถานะ
+type BookingStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    BookingStatus          `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=booking.v1.BookingStatus" json:"from_status,omitempty"` // UNSPECIFIED = ตอนสร้าง
+	ToStatus      BookingStatus          `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=booking.v1.BookingStatus" json:"to_status,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"` // user id หรือ system:<component>
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	AtIso         string                 `protobuf:"bytes,5,opt,name=at_iso,json=atIso,proto3" json:"at_iso,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	mi := &file_booking_v1_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChange) ProtoMessage() {}
+
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BookingStatusChange) GetFromStatus() BookingStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetToStatus() BookingStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetAtIso() string {
+	if x != nil {
+		return x.AtIso
+	}
+	return ""
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetBookingHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*BookingStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBookingHistoryResponse) GetChanges() []*BookingStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Promotion โค้ดส่วนลด (ADMIN จัดการ)
+type Promotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Kind           string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                    // PERCENT | FIXED
+	Value          int64                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`                                 // PERCENT: 1-99, FIXED: สตางค์
+	MaxDiscount    int64                  `protobuf:"varint,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`  // PERCENT: เพดานส่วนลด (สตางค์), 0 = ไม่จำกัด
+	MinAmount      int64                  `protobuf:"varint,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`        // ราคาก่อนลดขั้นต่ำ (สตางค์)
+	StartsAtIso    string                 `protobuf:"bytes,7,opt,name=starts_at_iso,json=startsAtIso,proto3" json:"starts_at_iso,omitempty"` // RFC3339; ช่วงที่ใช้โค้ดได้ (ว่าง = ไม่จำกัด)
+	EndsAtIso      string                 `protobuf:"bytes,8,opt,name=ends_at_iso,json=endsAtIso,proto3" json:"ends_at_iso,omitempty"`
+	Weekdays       []int32                `protobuf:"varint,9,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`          // วันที่เล่น 1 = จันทร์ ... 7 = อาทิตย์; ว่าง = ทุกวัน
+	TimeFrom       string                 `protobuf:"bytes,10,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"` // HH:mm เวลาเล่น (เวลาท้องถิ่นของสนาม)
+	TimeTo         string                 `protobuf:"bytes,11,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	UsageLimit     int32                  `protobuf:"varint,12,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"` // 0 = ไม่จำกัด
+	PerUserLimit   int32                  `protobuf:"varint,13,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	NewMembersOnly bool                   `protobuf:"varint,14,opt,name=new_members_only,json=newMembersOnly,proto3" json:"new_members_only,omitempty"`
+	CourtIds       []string               `protobuf:"bytes,15,rep,name=court_ids,json=courtIds,proto3" json:"court_ids,omitempty"` // ว่าง = ทุกสนาม
+	Venue          string                 `protobuf:"bytes,16,opt,name=venue,proto3" json:"venue,omitempty"`                       // ว่าง = ทุก venue
+	Active         bool                   `protobuf:"varint,17,opt,name=active,proto3" json:"active,omitempty"`
+	Used           int64                  `protobuf:"varint,18,opt,name=used,proto3" json:"used,omitempty"` // output: จองไว้ + ชำระแล้ว
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_booking_v1_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Promotion) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Promotion) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAtIso() string {
+	if x != nil {
+		return x.StartsAtIso
+	}
+	return ""
+}
+
+func (x *Promotion) GetEndsAtIso() string {
+	if x != nil {
+		return x.EndsAtIso
+	}
+	return ""
+}
+
+func (x *Promotion) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *Promotion) GetTimeFrom() string {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return ""
+}
+
+func (x *Promotion) GetTimeTo() string {
+	if x != nil {
+		return x.TimeTo
+	}
+	return ""
+}
+
+func (x *Promotion) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetNewMembersOnly() bool {
+	if x != nil {
+		return x.NewMembersOnly
+	}
+	return false
+}
+
+func (x *Promotion) GetCourtIds() []string {
+	if x != nil {
+		return x.CourtIds
+	}
+	return nil
+}
+
+func (x *Promotion) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promotion) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePromotionRequest) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPromotionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPromotionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPromotionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *ListPromotionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetPromotionActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromotionActiveRequest) Reset() {
+	*x = SetPromotionActiveRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromotionActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromotionActiveRequest) ProtoMessage() {}
+
+func (x *SetPromotionActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromotionActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetPromotionActiveRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetPromotionActiveRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetPromotionActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetPromotionActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromotionActiveResponse) Reset() {
+	*x = SetPromotionActiveResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromotionActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromotionActiveResponse) ProtoMessage() {}
+
+func (x *SetPromotionActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromotionActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetPromotionActiveResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetPromotionActiveResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+// PaymentShare ส่วนหนึ่งของค่าสนามเมื่อหารจ่าย; จ่ายผ่าน payment-service โดยส่ง share_id
+type PaymentShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ผู้ถูกเชิญ (ว่างถ้าเชิญด้วย email)
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` // สตางค์
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`  // PENDING | PAID | CANCELLED
+	Organizer     bool                   `protobuf:"varint,6,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,7,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	PaidAtIso     string                 `protobuf:"bytes,8,opt,name=paid_at_iso,json=paidAtIso,proto3" json:"paid_at_iso,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentShare) Reset() {
+	*x = PaymentShare{}
+	mi := &file_booking_v1_booking_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentShare) ProtoMessage() {}
+
+func (x *PaymentShare) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentShare.ProtoReflect.Descriptor instead.
+func (*PaymentShare) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PaymentShare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentShare) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentShare) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PaymentShare) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentShare) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentShare) GetOrganizer() bool {
+	if x != nil {
+		return x.Organizer
+	}
+	return false
+}
+
+func (x *PaymentShare) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentShare) GetPaidAtIso() string {
+	if x != nil {
+		return x.PaidAtIso
+	}
+	return ""
+}
+
+type SplitParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitParticipant) Reset() {
+	*x = SplitParticipant{}
+	mi := &file_booking_v1_booking_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitParticipant) ProtoMessage() {}
+
+func (x *SplitParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitParticipant.ProtoReflect.Descriptor instead.
+func (*SplitParticipant) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SplitParticipant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SplitParticipant) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SplitBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Participants  []*SplitParticipant    `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitBookingRequest) Reset() {
+	*x = SplitBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitBookingRequest) ProtoMessage() {}
+
+func (x *SplitBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitBookingRequest.ProtoReflect.Descriptor instead.
+func (*SplitBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SplitBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SplitBookingRequest) GetParticipants() []*SplitParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type SplitBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Shares        []*PaymentShare        `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitBookingResponse) Reset() {
+	*x = SplitBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitBookingResponse) ProtoMessage() {}
+
+func (x *SplitBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitBookingResponse.ProtoReflect.Descriptor instead.
+func (*SplitBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SplitBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *SplitBookingResponse) GetShares() []*PaymentShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// ผู้จัดจ่ายส่วนที่เหลือทั้งหมดแทน
+type CoverBookingSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverBookingSharesRequest) Reset() {
+	*x = CoverBookingSharesRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverBookingSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverBookingSharesRequest) ProtoMessage() {}
+
+func (x *CoverBookingSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverBookingSharesRequest.ProtoReflect.Descriptor instead.
+func (*CoverBookingSharesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CoverBookingSharesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CoverBookingSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Shares        []*PaymentShare        `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverBookingSharesResponse) Reset() {
+	*x = CoverBookingSharesResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverBookingSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverBookingSharesResponse) ProtoMessage() {}
+
+func (x *CoverBookingSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverBookingSharesResponse.ProtoReflect.Descriptor instead.
+func (*CoverBookingSharesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CoverBookingSharesResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CoverBookingSharesResponse) GetShares() []*PaymentShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+var File_booking_v1_booking_proto protoreflect.FileDescriptor
+
+const file_booking_v1_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x18booking/v1/booking.proto\x12\n" +
+	"booking.v1\"\x87\x03\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bcourt_id\x18\x03 \x01(\tR\acourtId\x12\x1b\n" +
+	"\tstart_iso\x18\x04 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x05 \x01(\tR\x06endIso\x121\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12$\n" +
+	"\x0eexpires_at_iso\x18\a \x01(\tR\fexpiresAtIso\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tseries_id\x18\n" +
+	" \x01(\tR\bseriesId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\v \x01(\tR\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\f \x01(\tR\tpromoCode\x12\x1a\n" +
+	"\bdiscount\x18\r \x01(\x03R\bdiscount\"\x9f\x01\n" +
+	"\x14CreateBookingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bcourt_id\x18\x02 \x01(\tR\acourtId\x12\x1b\n" +
+	"\tstart_iso\x18\x03 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x04 \x01(\tR\x06endIso\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x05 \x01(\tR\tpromoCode\"F\n" +
+	"\x15CreateBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"#\n" +
+	"\x11GetBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb4\x01\n" +
+	"\x12GetBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x120\n" +
+	"\x06shares\x18\x02 \x03(\v2\x18.booking.v1.PaymentShareR\x06shares\x12\x1f\n" +
+	"\vpaid_amount\x18\x03 \x01(\x03R\n" +
+	"paidAmount\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x03R\tremaining\"\x92\x01\n" +
+	"\x12ListBookingRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\bcourt_id\x18\x04 \x01(\tR\acourtId\x12\x17\n" +
+	"\aday_iso\x18\x05 \x01(\tR\x06dayIso\"\\\n" +
+	"\x13ListBookingResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"'\n" +
+	"\x15ConfirmBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x16ConfirmBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\">\n" +
+	"\x14CancelBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x15CancelBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"\x90\x01\n" +
+	"\x18RescheduleBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rnew_start_iso\x18\x02 \x01(\tR\vnewStartIso\x12\x1e\n" +
+	"\vnew_end_iso\x18\x03 \x01(\tR\tnewEndIso\x12 \n" +
+	"\fnew_court_id\x18\x04 \x01(\tR\n" +
+	"newCourtId\"J\n" +
+	"\x19RescheduleBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"T\n" +
+	"\bTimeSlot\x12\x1b\n" +
+	"\tstart_iso\x18\x01 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x02 \x01(\tR\x06endIso\x12\x12\n" +
+	"\x04free\x18\x03 \x01(\bR\x04free\"G\n" +
+	"\x16GetAvailabilityRequest\x12\x19\n" +
+	"\bcourt_id\x18\x01 \x01(\tR\acourtId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xaa\x01\n" +
+	"\x17GetAvailabilityResponse\x12\x19\n" +
+	"\bcourt_id\x18\x01 \x01(\tR\acourtId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\topen_from\x18\x03 \x01(\tR\bopenFrom\x12\x17\n" +
+	"\aopen_to\x18\x04 \x01(\tR\x06openTo\x12*\n" +
+	"\x05slots\x18\x05 \x03(\v2\x14.booking.v1.TimeSlotR\x05slots\"\xa4\x01\n" +
+	"\x13QuoteBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
+	"\bcourt_id\x18\x02 \x01(\tR\acourtId\x12\x1b\n" +
+	"\tstart_iso\x18\x03 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x04 \x01(\tR\x06endIso\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x05 \x01(\tR\tpromoCode\"\xc5\x01\n" +
+	"\x14QuoteBookingResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12$\n" +
+	"\x0eprice_per_hour\x18\x03 \x01(\x03R\fpricePerHour\x12\x18\n" +
+	"\aminutes\x18\x04 \x01(\x05R\aminutes\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x03R\bdiscount\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x06 \x01(\tR\tpromoCode\"\x7f\n" +
+	"\rBookingSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bcourt_id\x18\x03 \x01(\tR\acourtId\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xa2\x02\n" +
+	"\x1dCreateRecurringBookingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bcourt_id\x18\x02 \x01(\tR\acourtId\x12\x1b\n" +
+	"\tstart_iso\x18\x03 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x04 \x01(\tR\x06endIso\x12%\n" +
+	"\x0einterval_weeks\x18\x05 \x01(\x05R\rintervalWeeks\x12\x1b\n" +
+	"\tuntil_iso\x18\x06 \x01(\tR\buntilIso\x12\x14\n" +
+	"\x05count\x18\a \x01(\x05R\x05count\x12=\n" +
+	"\rconflict_mode\x18\b \x01(\x0e2\x18.booking.v1.ConflictModeR\fconflictMode\"b\n" +
+	"\x12OccurrenceConflict\x12\x1b\n" +
+	"\tstart_iso\x18\x01 \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\x02 \x01(\tR\x06endIso\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc2\x01\n" +
+	"\x1eCreateRecurringBookingResponse\x121\n" +
+	"\x06series\x18\x01 \x01(\v2\x19.booking.v1.BookingSeriesR\x06series\x12/\n" +
+	"\bbookings\x18\x02 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12<\n" +
+	"\tconflicts\x18\x03 \x03(\v2\x1e.booking.v1.OccurrenceConflictR\tconflicts\"Q\n" +
+	"\x1aCancelBookingSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"N\n" +
+	"\x1bCancelBookingSeriesResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\"w\n" +
+	"\x1aUpdateBookingStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"L\n" +
+	"\x1bUpdateBookingStatusResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"\xce\x01\n" +
+	"\x13BookingStatusChange\x12:\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x15\n" +
+	"\x06at_iso\x18\x05 \x01(\tR\x05atIso\"*\n" +
+	"\x18GetBookingHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x19GetBookingHistoryResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.booking.v1.BookingStatusChangeR\achanges\"\x93\x04\n" +
+	"\tPromotion\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x03R\x05value\x12!\n" +
+	"\fmax_discount\x18\x05 \x01(\x03R\vmaxDiscount\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x06 \x01(\x03R\tminAmount\x12\"\n" +
+	"\rstarts_at_iso\x18\a \x01(\tR\vstartsAtIso\x12\x1e\n" +
+	"\vends_at_iso\x18\b \x01(\tR\tendsAtIso\x12\x1a\n" +
+	"\bweekdays\x18\t \x03(\x05R\bweekdays\x12\x1b\n" +
+	"\ttime_from\x18\n" +
+	" \x01(\tR\btimeFrom\x12\x17\n" +
+	"\atime_to\x18\v \x01(\tR\x06timeTo\x12\x1f\n" +
+	"\vusage_limit\x18\f \x01(\x05R\n" +
+	"usageLimit\x12$\n" +
+	"\x0eper_user_limit\x18\r \x01(\x05R\fperUserLimit\x12(\n" +
+	"\x10new_members_only\x18\x0e \x01(\bR\x0enewMembersOnly\x12\x1b\n" +
+	"\tcourt_ids\x18\x0f \x03(\tR\bcourtIds\x12\x14\n" +
+	"\x05venue\x18\x10 \x01(\tR\x05venue\x12\x16\n" +
+	"\x06active\x18\x11 \x01(\bR\x06active\x12\x12\n" +
+	"\x04used\x18\x12 \x01(\x03R\x04used\"M\n" +
+	"\x16CreatePromotionRequest\x123\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x15.booking.v1.PromotionR\tpromotion\"N\n" +
+	"\x17CreatePromotionResponse\x123\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x15.booking.v1.PromotionR\tpromotion\"i\n" +
+	"\x15ListPromotionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\"e\n" +
+	"\x16ListPromotionsResponse\x125\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x15.booking.v1.PromotionR\n" +
+	"promotions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"G\n" +
+	"\x19SetPromotionActiveRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"Q\n" +
+	"\x1aSetPromotionActiveResponse\x123\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x15.booking.v1.PromotionR\tpromotion\"\xda\x01\n" +
+	"\fPaymentShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
+	"\torganizer\x18\x06 \x01(\bR\torganizer\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\a \x01(\tR\tpaymentId\x12\x1e\n" +
+	"\vpaid_at_iso\x18\b \x01(\tR\tpaidAtIso\"A\n" +
+	"\x10SplitParticipant\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"g\n" +
+	"\x13SplitBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12@\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1c.booking.v1.SplitParticipantR\fparticipants\"w\n" +
+	"\x14SplitBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x120\n" +
+	"\x06shares\x18\x02 \x03(\v2\x18.booking.v1.PaymentShareR\x06shares\"+\n" +
+	"\x19CoverBookingSharesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"}\n" +
+	"\x1aCoverBookingSharesResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x120\n" +
+	"\x06shares\x18\x02 \x03(\v2\x18.booking.v1.PaymentShareR\x06shares*\x83\x01\n" +
 	"\rBookingStatus\x12\x1e\n" +
 	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\r\n" +
 	"\tCONFIRMED\x10\x02\x12\r\n" +
-	"\tCANCELLED\x10\x032\xb2\x03\n" +
+	"\tCANCELLED\x10\x03\x12\v\n" +
+	"\aEXPIRED\x10\x04\x12\r\n" +
+	"\tCOMPLETED\x10\x05\x12\v\n" +
+	"\aNO_SHOW\x10\x06*U\n" +
+	"\fConflictMode\x12\x1d\n" +
+	"\x19CONFLICT_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eALL_OR_NOTHING\x10\x01\x12\x12\n" +
+	"\x0eSKIP_CONFLICTS\x10\x022\xb8\f\n" +
 	"\x0eBookingService\x12T\n" +
 	"\rCreateBooking\x12 .booking.v1.CreateBookingRequest\x1a!.booking.v1.CreateBookingResponse\x12K\n" +
 	"\n" +
 	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.GetBookingResponse\x12N\n" +
 	"\vListBooking\x12\x1e.booking.v1.ListBookingRequest\x1a\x1f.booking.v1.ListBookingResponse\x12W\n" +
 	"\x0eConfirmBooking\x12!.booking.v1.ConfirmBookingRequest\x1a\".booking.v1.ConfirmBookingResponse\x12T\n" +
-	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a!.booking.v1.CancelBookingResponseB=Z;github.com/you/badminton-booking/proto/booking/v1;bookingv1b\x06proto3"
+	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a!.booking.v1.CancelBookingResponse\x12`\n" +
+	"\x11RescheduleBooking\x12$.booking.v1.RescheduleBookingRequest\x1a%.booking.v1.RescheduleBookingResponse\x12Q\n" +
+	"\fQuoteBooking\x12\x1f.booking.v1.QuoteBookingRequest\x1a .booking.v1.QuoteBookingResponse\x12Z\n" +
+	"\x0fGetAvailability\x12\".booking.v1.GetAvailabilityRequest\x1a#.booking.v1.GetAvailabilityResponse\x12o\n" +
+	"\x16CreateRecurringBooking\x12).booking.v1.CreateRecurringBookingRequest\x1a*.booking.v1.CreateRecurringBookingResponse\x12f\n" +
+	"\x13CancelBookingSeries\x12&.booking.v1.CancelBookingSeriesRequest\x1a'.booking.v1.CancelBookingSeriesResponse\x12f\n" +
+	"\x13UpdateBookingStatus\x12&.booking.v1.UpdateBookingStatusRequest\x1a'.booking.v1.UpdateBookingStatusResponse\x12`\n" +
+	"\x11GetBookingHistory\x12$.booking.v1.GetBookingHistoryRequest\x1a%.booking.v1.GetBookingHistoryResponse\x12Z\n" +
+	"\x0fCreatePromotion\x12\".booking.v1.CreatePromotionRequest\x1a#.booking.v1.CreatePromotionResponse\x12W\n" +
+	"\x0eListPromotions\x12!.booking.v1.ListPromotionsRequest\x1a\".booking.v1.ListPromotionsResponse\x12c\n" +
+	"\x12SetPromotionActive\x12%.booking.v1.SetPromotionActiveRequest\x1a&.booking.v1.SetPromotionActiveResponse\x12Q\n" +
+	"\fSplitBooking\x12\x1f.booking.v1.SplitBookingRequest\x1a .booking.v1.SplitBookingResponse\x12c\n" +
+	"\x12CoverBookingShares\x12%.booking.v1.CoverBookingSharesRequest\x1a&.booking.v1.CoverBookingSharesResponseB=Z;github.com/you/badminton-booking/proto/booking/v1;bookingv1b\x06proto3"
 
 var (
 	file_booking_v1_booking_proto_rawDescOnce sync.Once
@@ -727,44 +3020,122 @@ func file_booking_v1_booking_proto_rawDescGZIP() []byte {
 	return file_booking_v1_booking_proto_rawDescData
 }
 
-var file_booking_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_booking_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_booking_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_booking_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_booking_v1_booking_proto_goTypes = []any{
-	(BookingStatus)(0),             // 0: booking.v1.BookingStatus
-	(*Booking)(nil),                // 1: booking.v1.Booking
-	(*CreateBookingRequest)(nil),   // 2: booking.v1.CreateBookingRequest
-	(*CreateBookingResponse)(nil),  // 3: booking.v1.CreateBookingResponse
-	(*GetBookingRequest)(nil),      // 4: booking.v1.GetBookingRequest
-	(*GetBookingResponse)(nil),     // 5: booking.v1.GetBookingResponse
-	(*ListBookingRequest)(nil),     // 6: booking.v1.ListBookingRequest
-	(*ListBookingResponse)(nil),    // 7: booking.v1.ListBookingResponse
-	(*ConfirmBookingRequest)(nil),  // 8: booking.v1.ConfirmBookingRequest
-	(*ConfirmBookingResponse)(nil), // 9: booking.v1.ConfirmBookingResponse
-	(*CancelBookingRequest)(nil),   // 10: booking.v1.CancelBookingRequest
-	(*CancelBookingResponse)(nil),  // 11: booking.v1.CancelBookingResponse
+	(BookingStatus)(0),                     // 0: booking.v1.BookingStatus
+	(ConflictMode)(0),                      // 1: booking.v1.ConflictMode
+	(*Booking)(nil),                        // 2: booking.v1.Booking
+	(*CreateBookingRequest)(nil),           // 3: booking.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),          // 4: booking.v1.CreateBookingResponse
+	(*GetBookingRequest)(nil),              // 5: booking.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),             // 6: booking.v1.GetBookingResponse
+	(*ListBookingRequest)(nil),             // 7: booking.v1.ListBookingRequest
+	(*ListBookingResponse)(nil),            // 8: booking.v1.ListBookingResponse
+	(*ConfirmBookingRequest)(nil),          // 9: booking.v1.ConfirmBookingRequest
+	(*ConfirmBookingResponse)(nil),         // 10: booking.v1.ConfirmBookingResponse
+	(*CancelBookingRequest)(nil),           // 11: booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),          // 12: booking.v1.CancelBookingResponse
+	(*RescheduleBookingRequest)(nil),       // 13: booking.v1.RescheduleBookingRequest
+	(*RescheduleBookingResponse)(nil),      // 14: booking.v1.RescheduleBookingResponse
+	(*TimeSlot)(nil),                       // 15: booking.v1.TimeSlot
+	(*GetAvailabilityRequest)(nil),         // 16: booking.v1.GetAvailabilityRequest
+	(*GetAvailabilityResponse)(nil),        // 17: booking.v1.GetAvailabilityResponse
+	(*QuoteBookingRequest)(nil),            // 18: booking.v1.QuoteBookingRequest
+	(*QuoteBookingResponse)(nil),           // 19: booking.v1.QuoteBookingResponse
+	(*BookingSeries)(nil),                  // 20: booking.v1.BookingSeries
+	(*CreateRecurringBookingRequest)(nil),  // 21: booking.v1.CreateRecurringBookingRequest
+	(*OccurrenceConflict)(nil),             // 22: booking.v1.OccurrenceConflict
+	(*CreateRecurringBookingResponse)(nil), // 23: booking.v1.CreateRecurringBookingResponse
+	(*CancelBookingSeriesRequest)(nil),     // 24: booking.v1.CancelBookingSeriesRequest
+	(*CancelBookingSeriesResponse)(nil),    // 25: booking.v1.CancelBookingSeriesResponse
+	(*UpdateBookingStatusRequest)(nil),     // 26: booking.v1.UpdateBookingStatusRequest
+	(*UpdateBookingStatusResponse)(nil),    // 27: booking.v1.UpdateBookingStatusResponse
+	(*BookingStatusChange)(nil),            // 28: booking.v1.BookingStatusChange
+	(*GetBookingHistoryRequest)(nil),       // 29: booking.v1.GetBookingHistoryRequest
+	(*GetBookingHistoryResponse)(nil),      // 30: booking.v1.GetBookingHistoryResponse
+	(*Promotion)(nil),                      // 31: booking.v1.Promotion
+	(*CreatePromotionRequest)(nil),         // 32: booking.v1.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),        // 33: booking.v1.CreatePromotionResponse
+	(*ListPromotionsRequest)(nil),          // 34: booking.v1.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),         // 35: booking.v1.ListPromotionsResponse
+	(*SetPromotionActiveRequest)(nil),      // 36: booking.v1.SetPromotionActiveRequest
+	(*SetPromotionActiveResponse)(nil),     // 37: booking.v1.SetPromotionActiveResponse
+	(*PaymentShare)(nil),                   // 38: booking.v1.PaymentShare
+	(*SplitParticipant)(nil),               // 39: booking.v1.SplitParticipant
+	(*SplitBookingRequest)(nil),            // 40: booking.v1.SplitBookingRequest
+	(*SplitBookingResponse)(nil),           // 41: booking.v1.SplitBookingResponse
+	(*CoverBookingSharesRequest)(nil),      // 42: booking.v1.CoverBookingSharesRequest
+	(*CoverBookingSharesResponse)(nil),     // 43: booking.v1.CoverBookingSharesResponse
 }
 var file_booking_v1_booking_proto_depIdxs = []int32{
 	0,  // 0: booking.v1.Booking.status:type_name -> booking.v1.BookingStatus
-	1,  // 1: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
-	1,  // 2: booking.v1.GetBookingResponse.booking:type_name -> booking.v1.Booking
-	1,  // 3: booking.v1.ListBookingResponse.bookings:type_name -> booking.v1.Booking
-	1,  // 4: booking.v1.ConfirmBookingResponse.booking:type_name -> booking.v1.Booking
-	1,  // 5: booking.v1.CancelBookingResponse.booking:type_name -> booking.v1.Booking
-	2,  // 6: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
-	4,  // 7: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
-	6,  // 8: booking.v1.BookingService.ListBooking:input_type -> booking.v1.ListBookingRequest
-	8,  // 9: booking.v1.BookingService.ConfirmBooking:input_type -> booking.v1.ConfirmBookingRequest
-	10, // 10: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
-	3,  // 11: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
-	5,  // 12: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
-	7,  // 13: booking.v1.BookingService.ListBooking:output_type -> booking.v1.ListBookingResponse
-	9,  // 14: booking.v1.BookingService.ConfirmBooking:output_type -> booking.v1.ConfirmBookingResponse
-	11, // 15: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CancelBookingResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	2,  // 1: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
+	2,  // 2: booking.v1.GetBookingResponse.booking:type_name -> booking.v1.Booking
+	38, // 3: booking.v1.GetBookingResponse.shares:type_name -> booking.v1.PaymentShare
+	2,  // 4: booking.v1.ListBookingResponse.bookings:type_name -> booking.v1.Booking
+	2,  // 5: booking.v1.ConfirmBookingResponse.booking:type_name -> booking.v1.Booking
+	2,  // 6: booking.v1.CancelBookingResponse.booking:type_name -> booking.v1.Booking
+	2,  // 7: booking.v1.RescheduleBookingResponse.booking:type_name -> booking.v1.Booking
+	15, // 8: booking.v1.GetAvailabilityResponse.slots:type_name -> booking.v1.TimeSlot
+	1,  // 9: booking.v1.CreateRecurringBookingRequest.conflict_mode:type_name -> booking.v1.ConflictMode
+	20, // 10: booking.v1.CreateRecurringBookingResponse.series:type_name -> booking.v1.BookingSeries
+	2,  // 11: booking.v1.CreateRecurringBookingResponse.bookings:type_name -> booking.v1.Booking
+	22, // 12: booking.v1.CreateRecurringBookingResponse.conflicts:type_name -> booking.v1.OccurrenceConflict
+	2,  // 13: booking.v1.CancelBookingSeriesResponse.bookings:type_name -> booking.v1.Booking
+	0,  // 14: booking.v1.UpdateBookingStatusRequest.status:type_name -> booking.v1.BookingStatus
+	2,  // 15: booking.v1.UpdateBookingStatusResponse.booking:type_name -> booking.v1.Booking
+	0,  // 16: booking.v1.BookingStatusChange.from_status:type_name -> booking.v1.BookingStatus
+	0,  // 17: booking.v1.BookingStatusChange.to_status:type_name -> booking.v1.BookingStatus
+	28, // 18: booking.v1.GetBookingHistoryResponse.changes:type_name -> booking.v1.BookingStatusChange
+	31, // 19: booking.v1.CreatePromotionRequest.promotion:type_name -> booking.v1.Promotion
+	31, // 20: booking.v1.CreatePromotionResponse.promotion:type_name -> booking.v1.Promotion
+	31, // 21: booking.v1.ListPromotionsResponse.promotions:type_name -> booking.v1.Promotion
+	31, // 22: booking.v1.SetPromotionActiveResponse.promotion:type_name -> booking.v1.Promotion
+	39, // 23: booking.v1.SplitBookingRequest.participants:type_name -> booking.v1.SplitParticipant
+	2,  // 24: booking.v1.SplitBookingResponse.booking:type_name -> booking.v1.Booking
+	38, // 25: booking.v1.SplitBookingResponse.shares:type_name -> booking.v1.PaymentShare
+	2,  // 26: booking.v1.CoverBookingSharesResponse.booking:type_name -> booking.v1.Booking
+	38, // 27: booking.v1.CoverBookingSharesResponse.shares:type_name -> booking.v1.PaymentShare
+	3,  // 28: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
+	5,  // 29: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
+	7,  // 30: booking.v1.BookingService.ListBooking:input_type -> booking.v1.ListBookingRequest
+	9,  // 31: booking.v1.BookingService.ConfirmBooking:input_type -> booking.v1.ConfirmBookingRequest
+	11, // 32: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
+	13, // 33: booking.v1.BookingService.RescheduleBooking:input_type -> booking.v1.RescheduleBookingRequest
+	18, // 34: booking.v1.BookingService.QuoteBooking:input_type -> booking.v1.QuoteBookingRequest
+	16, // 35: booking.v1.BookingService.GetAvailability:input_type -> booking.v1.GetAvailabilityRequest
+	21, // 36: booking.v1.BookingService.CreateRecurringBooking:input_type -> booking.v1.CreateRecurringBookingRequest
+	24, // 37: booking.v1.BookingService.CancelBookingSeries:input_type -> booking.v1.CancelBookingSeriesRequest
+	26, // 38: booking.v1.BookingService.UpdateBookingStatus:input_type -> booking.v1.UpdateBookingStatusRequest
+	29, // 39: booking.v1.BookingService.GetBookingHistory:input_type -> booking.v1.GetBookingHistoryRequest
+	32, // 40: booking.v1.BookingService.CreatePromotion:input_type -> booking.v1.CreatePromotionRequest
+	34, // 41: booking.v1.BookingService.ListPromotions:input_type -> booking.v1.ListPromotionsRequest
+	36, // 42: booking.v1.BookingService.SetPromotionActive:input_type -> booking.v1.SetPromotionActiveRequest
+	40, // 43: booking.v1.BookingService.SplitBooking:input_type -> booking.v1.SplitBookingRequest
+	42, // 44: booking.v1.BookingService.CoverBookingShares:input_type -> booking.v1.CoverBookingSharesRequest
+	4,  // 45: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
+	6,  // 46: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
+	8,  // 47: booking.v1.BookingService.ListBooking:output_type -> booking.v1.ListBookingResponse
+	10, // 48: booking.v1.BookingService.ConfirmBooking:output_type -> booking.v1.ConfirmBookingResponse
+	12, // 49: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CancelBookingResponse
+	14, // 50: booking.v1.BookingService.RescheduleBooking:output_type -> booking.v1.RescheduleBookingResponse
+	19, // 51: booking.v1.BookingService.QuoteBooking:output_type -> booking.v1.QuoteBookingResponse
+	17, // 52: booking.v1.BookingService.GetAvailability:output_type -> booking.v1.GetAvailabilityResponse
+	23, // 53: booking.v1.BookingService.CreateRecurringBooking:output_type -> booking.v1.CreateRecurringBookingResponse
+	25, // 54: booking.v1.BookingService.CancelBookingSeries:output_type -> booking.v1.CancelBookingSeriesResponse
+	27, // 55: booking.v1.BookingService.UpdateBookingStatus:output_type -> booking.v1.UpdateBookingStatusResponse
+	30, // 56: booking.v1.BookingService.GetBookingHistory:output_type -> booking.v1.GetBookingHistoryResponse
+	33, // 57: booking.v1.BookingService.CreatePromotion:output_type -> booking.v1.CreatePromotionResponse
+	35, // 58: booking.v1.BookingService.ListPromotions:output_type -> booking.v1.ListPromotionsResponse
+	37, // 59: booking.v1.BookingService.SetPromotionActive:output_type -> booking.v1.SetPromotionActiveResponse
+	41, // 60: booking.v1.BookingService.SplitBooking:output_type -> booking.v1.SplitBookingResponse
+	43, // 61: booking.v1.BookingService.CoverBookingShares:output_type -> booking.v1.CoverBookingSharesResponse
+	45, // [45:62] is the sub-list for method output_type
+	28, // [28:45] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_booking_v1_booking_proto_init() }
@@ -777,8 +3148,8 @@ func file_booking_v1_booking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_booking_proto_rawDesc), len(file_booking_v1_booking_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName  = "/booking.v1.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName     = "/booking.v1.BookingService/GetBooking"
-	BookingService_ListBooking_FullMethodName    = "/booking.v1.BookingService/ListBooking"
-	BookingService_ConfirmBooking_FullMethodName = "/booking.v1.BookingService/ConfirmBooking"
-	BookingService_CancelBooking_FullMethodName  = "/booking.v1.BookingService/CancelBooking"
+	BookingService_CreateBooking_FullMethodName          = "/booking.v1.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName             = "/booking.v1.BookingService/GetBooking"
+	BookingService_ListBooking_FullMethodName            = "/booking.v1.BookingService/ListBooking"
+	BookingService_ConfirmBooking_FullMethodName         = "/booking.v1.BookingService/ConfirmBooking"
+	BookingService_CancelBooking_FullMethodName          = "/booking.v1.BookingService/CancelBooking"
+	BookingService_RescheduleBooking_FullMethodName      = "/booking.v1.BookingService/RescheduleBooking"
+	BookingService_QuoteBooking_FullMethodName           = "/booking.v1.BookingService/QuoteBooking"
+	BookingService_GetAvailability_FullMethodName        = "/booking.v1.BookingService/GetAvailability"
+	BookingService_CreateRecurringBooking_FullMethodName = "/booking.v1.BookingService/CreateRecurringBooking"
+	BookingService_CancelBookingSeries_FullMethodName    = "/booking.v1.BookingService/CancelBookingSeries"
+	BookingService_UpdateBookingStatus_FullMethodName    = "/booking.v1.BookingService/UpdateBookingStatus"
+	BookingService_GetBookingHistory_FullMethodName      = "/booking.v1.BookingService/GetBookingHistory"
+	BookingService_CreatePromotion_FullMethodName        = "/booking.v1.BookingService/CreatePromotion"
+	BookingService_ListPromotions_FullMethodName         = "/booking.v1.BookingService/ListPromotions"
+	BookingService_SetPromotionActive_FullMethodName     = "/booking.v1.BookingService/SetPromotionActive"
+	BookingService_SplitBooking_FullMethodName           = "/booking.v1.BookingService/SplitBooking"
+	BookingService_CoverBookingShares_FullMethodName     = "/booking.v1.BookingService/CoverBookingShares"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -35,6 +47,18 @@ type BookingServiceClient interface {
 	ListBooking(ctx context.Context, in *ListBookingRequest, opts ...grpc.CallOption) (*ListBookingResponse, error)
 	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*ConfirmBookingResponse, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*RescheduleBookingResponse, error)
+	QuoteBooking(ctx context.Context, in *QuoteBookingRequest, opts ...grpc.CallOption) (*QuoteBookingResponse, error)
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error)
+	CreateRecurringBooking(ctx context.Context, in *CreateRecurringBookingRequest, opts ...grpc.CallOption) (*CreateRecurringBookingResponse, error)
+	CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error)
+	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error)
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	SetPromotionActive(ctx context.Context, in *SetPromotionActiveRequest, opts ...grpc.CallOption) (*SetPromotionActiveResponse, error)
+	SplitBooking(ctx context.Context, in *SplitBookingRequest, opts ...grpc.CallOption) (*SplitBookingResponse, error)
+	CoverBookingShares(ctx context.Context, in *CoverBookingSharesRequest, opts ...grpc.CallOption) (*CoverBookingSharesResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -95,6 +119,126 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*RescheduleBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_RescheduleBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) QuoteBooking(ctx context.Context, in *QuoteBookingRequest, opts ...grpc.CallOption) (*QuoteBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_QuoteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateRecurringBooking(ctx context.Context, in *CreateRecurringBookingRequest, opts ...grpc.CallOption) (*CreateRecurringBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecurringBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateRecurringBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBookingStatusResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateBookingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetPromotionActive(ctx context.Context, in *SetPromotionActiveRequest, opts ...grpc.CallOption) (*SetPromotionActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPromotionActiveResponse)
+	err := c.cc.Invoke(ctx, BookingService_SetPromotionActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SplitBooking(ctx context.Context, in *SplitBookingRequest, opts ...grpc.CallOption) (*SplitBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_SplitBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CoverBookingShares(ctx context.Context, in *CoverBookingSharesRequest, opts ...grpc.CallOption) (*CoverBookingSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoverBookingSharesResponse)
+	err := c.cc.Invoke(ctx, BookingService_CoverBookingShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -104,6 +248,18 @@ type BookingServiceServer interface {
 	ListBooking(context.Context, *ListBookingRequest) (*ListBookingResponse, error)
 	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*ConfirmBookingResponse, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	RescheduleBooking(context.Context, *RescheduleBookingRequest) (*RescheduleBookingResponse, error)
+	QuoteBooking(context.Context, *QuoteBookingRequest) (*QuoteBookingResponse, error)
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error)
+	CreateRecurringBooking(context.Context, *CreateRecurringBookingRequest) (*CreateRecurringBookingResponse, error)
+	CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error)
+	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error)
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error)
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	SetPromotionActive(context.Context, *SetPromotionActiveRequest) (*SetPromotionActiveResponse, error)
+	SplitBooking(context.Context, *SplitBookingRequest) (*SplitBookingResponse, error)
+	CoverBookingShares(context.Context, *CoverBookingSharesRequest) (*CoverBookingSharesResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -129,6 +285,42 @@ func (UnimplementedBookingServiceServer) ConfirmBooking(context.Context, *Confir
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) RescheduleBooking(context.Context, *RescheduleBookingRequest) (*RescheduleBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) QuoteBooking(context.Context, *QuoteBookingRequest) (*QuoteBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateRecurringBooking(context.Context, *CreateRecurringBookingRequest) (*CreateRecurringBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookingStatus not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedBookingServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedBookingServiceServer) SetPromotionActive(context.Context, *SetPromotionActiveRequest) (*SetPromotionActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPromotionActive not implemented")
+}
+func (UnimplementedBookingServiceServer) SplitBooking(context.Context, *SplitBookingRequest) (*SplitBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CoverBookingShares(context.Context, *CoverBookingSharesRequest) (*CoverBookingSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoverBookingShares not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +432,222 @@ func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_RescheduleBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RescheduleBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, req.(*RescheduleBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_QuoteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).QuoteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_QuoteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).QuoteBooking(ctx, req.(*QuoteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateRecurringBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateRecurringBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateRecurringBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateRecurringBooking(ctx, req.(*CreateRecurringBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, req.(*CancelBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBookingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, req.(*UpdateBookingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetPromotionActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPromotionActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetPromotionActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetPromotionActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetPromotionActive(ctx, req.(*SetPromotionActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SplitBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SplitBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SplitBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SplitBooking(ctx, req.(*SplitBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CoverBookingShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverBookingSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CoverBookingShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CoverBookingShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CoverBookingShares(ctx, req.(*CoverBookingSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +675,54 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "RescheduleBooking",
+			Handler:    _BookingService_RescheduleBooking_Handler,
+		},
+		{
+			MethodName: "QuoteBooking",
+			Handler:    _BookingService_QuoteBooking_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _BookingService_GetAvailability_Handler,
+		},
+		{
+			MethodName: "CreateRecurringBooking",
+			Handler:    _BookingService_CreateRecurringBooking_Handler,
+		},
+		{
+			MethodName: "CancelBookingSeries",
+			Handler:    _BookingService_CancelBookingSeries_Handler,
+		},
+		{
+			MethodName: "UpdateBookingStatus",
+			Handler:    _BookingService_UpdateBookingStatus_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _BookingService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _BookingService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "SetPromotionActive",
+			Handler:    _BookingService_SetPromotionActive_Handler,
+		},
+		{
+			MethodName: "SplitBooking",
+			Handler:    _BookingService_SplitBooking_Handler,
+		},
+		{
+			MethodName: "CoverBookingShares",
+			Handler:    _BookingService_CoverBookingShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking/v1/booking.proto",
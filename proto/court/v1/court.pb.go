@@ -120,6 +120,7 @@ type CreateCourtRequest struct {
 	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"`
 	OpenFrom      string                 `protobuf:"bytes,4,opt,name=open_from,json=openFrom,proto3" json:"open_from,omitempty"`
 	OpenTo        string                 `protobuf:"bytes,5,opt,name=open_to,json=openTo,proto3" json:"open_to,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // gateway ใส่จาก JWT (sub)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +190,13 @@ func (x *CreateCourtRequest) GetOpenTo() string {
 	return ""
 }
 
+func (x *CreateCourtRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
 type CreateCourtResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Court         *Court                 `protobuf:"bytes,1,opt,name=court,proto3" json:"court,omitempty"`
@@ -326,6 +334,7 @@ type ListCourtsRequest struct {
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	VenueQuery    string                 `protobuf:"bytes,3,opt,name=venue_query,json=venueQuery,proto3" json:"venue_query,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // optional filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -381,6 +390,13 @@ func (x *ListCourtsRequest) GetVenueQuery() string {
 	return ""
 }
 
+func (x *ListCourtsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
 type ListCourtsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Courts        []*Court               `protobuf:"bytes,1,rep,name=courts,proto3" json:"courts,omitempty"`
@@ -645,24 +661,26 @@ const file_court_v1_court_proto_rawDesc = "" +
 	"\x0eprice_per_hour\x18\x04 \x01(\x03R\fpricePerHour\x12\x1b\n" +
 	"\topen_from\x18\x05 \x01(\tR\bopenFrom\x12\x17\n" +
 	"\aopen_to\x18\x06 \x01(\tR\x06openTo\x12\x19\n" +
-	"\bowner_id\x18\a \x01(\tR\aownerId\"\xa1\x01\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\"\xbc\x01\n" +
 	"\x12CreateCourtRequest\x12\x14\n" +
 	"\x05venue\x18\x01 \x01(\tR\x05venue\x12\x19\n" +
 	"\bcourt_no\x18\x02 \x01(\x05R\acourtNo\x12$\n" +
 	"\x0eprice_per_hour\x18\x03 \x01(\x03R\fpricePerHour\x12\x1b\n" +
 	"\topen_from\x18\x04 \x01(\tR\bopenFrom\x12\x17\n" +
-	"\aopen_to\x18\x05 \x01(\tR\x06openTo\"<\n" +
+	"\aopen_to\x18\x05 \x01(\tR\x06openTo\x12\x19\n" +
+	"\bowner_id\x18\x06 \x01(\tR\aownerId\"<\n" +
 	"\x13CreateCourtResponse\x12%\n" +
 	"\x05court\x18\x01 \x01(\v2\x0f.court.v1.CourtR\x05court\"!\n" +
 	"\x0fGetCourtRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
 	"\x10GetCourtResponse\x12%\n" +
-	"\x05court\x18\x01 \x01(\v2\x0f.court.v1.CourtR\x05court\"e\n" +
+	"\x05court\x18\x01 \x01(\v2\x0f.court.v1.CourtR\x05court\"\x80\x01\n" +
 	"\x11ListCourtsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vvenue_query\x18\x03 \x01(\tR\n" +
-	"venueQuery\"=\n" +
+	"venueQuery\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"=\n" +
 	"\x12ListCourtsResponse\x12'\n" +
 	"\x06courts\x18\x01 \x03(\v2\x0f.court.v1.CourtR\x06courts\"\xb1\x01\n" +
 	"\x12UpdateCourtRequest\x12\x0e\n" +
@@ -22,13 +22,15 @@ const (
 )
 
 type CreateCardChargeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	CardToken     string                 `protobuf:"bytes,4,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	CardToken      string                 `protobuf:"bytes,4,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // จาก header Idempotency-Key; key เดิม = ได้ charge เดิม
+	ShareId        string                 `protobuf:"bytes,6,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`                      // booking หารจ่าย: ส่วนที่จ่าย (ยอดต้องตรงกับส่วนนั้น)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateCardChargeRequest) Reset() {
@@ -89,6 +91,20 @@ func (x *CreateCardChargeRequest) GetCardToken() string {
 	return ""
 }
 
+func (x *CreateCardChargeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateCardChargeRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
 type CreateCardChargeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
@@ -157,9 +173,11 @@ type CreateSourceChargeRequest struct {
 	SourceId  string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`    // เส้นทางเดิม (ถ้ามี)
 	ReturnUri string                 `protobuf:"bytes,5,opt,name=return_uri,json=returnUri,proto3" json:"return_uri,omitempty"` // สำหรับช่องทางต้อง redirect (จากฝั่ง client เมื่อสร้าง source เอง)
 	// NEW: ให้ server สร้าง source ให้ถ้า client ไม่ส่ง source_id
-	SourceType    string `protobuf:"bytes,6,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // เช่น "promptpay", "internet_banking_kbank"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SourceType     string `protobuf:"bytes,6,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`             // เช่น "promptpay", "internet_banking_kbank"
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // จาก header Idempotency-Key; key เดิม = ได้ charge เดิม
+	ShareId        string `protobuf:"bytes,8,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`                      // booking หารจ่าย: ส่วนที่จ่าย (ยอดต้องตรงกับส่วนนั้น)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateSourceChargeRequest) Reset() {
@@ -234,11 +252,28 @@ func (x *CreateSourceChargeRequest) GetSourceType() string {
 	return ""
 }
 
+func (x *CreateSourceChargeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateSourceChargeRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
 type CreateSourceChargeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
-	AuthorizeUri  string                 `protobuf:"bytes,2,opt,name=authorize_uri,json=authorizeUri,proto3" json:"authorize_uri,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId     string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	AuthorizeUri string                 `protobuf:"bytes,2,opt,name=authorize_uri,json=authorizeUri,proto3" json:"authorize_uri,omitempty"`
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// promptpay: Omise ให้ QR เป็นรูป (ไม่มี payload ดิบ) ให้ kiosk แสดงรูปนี้ได้เลย
+	QrImageUri    string `protobuf:"bytes,4,opt,name=qr_image_uri,json=qrImageUri,proto3" json:"qr_image_uri,omitempty"`
+	ExpiresAtIso  string `protobuf:"bytes,5,opt,name=expires_at_iso,json=expiresAtIso,proto3" json:"expires_at_iso,omitempty"` // RFC3339 UTC; หลังจากนี้ charge จะเป็น expired
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,6 +329,20 @@ func (x *CreateSourceChargeResponse) GetStatus() string {
 	return ""
 }
 
+func (x *CreateSourceChargeResponse) GetQrImageUri() string {
+	if x != nil {
+		return x.QrImageUri
+	}
+	return ""
+}
+
+func (x *CreateSourceChargeResponse) GetExpiresAtIso() string {
+	if x != nil {
+		return x.ExpiresAtIso
+	}
+	return ""
+}
+
 type GetChargeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
@@ -406,48 +455,2563 @@ func (x *GetChargeResponse) GetFailureMessage() string {
 	return ""
 }
 
-var File_payment_v1_payment_proto protoreflect.FileDescriptor
+// Payment แถวใน ledger ของ payment-service (หนึ่งแถวต่อ charge)
+type Payment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId       string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	BookingId      string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Method         string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"` // card / promptpay / internet_banking_* ...
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending / successful / failed / ...
+	FailureCode    string                 `protobuf:"bytes,8,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	FailureMessage string                 `protobuf:"bytes,9,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	CreatedAtIso   string                 `protobuf:"bytes,10,opt,name=created_at_iso,json=createdAtIso,proto3" json:"created_at_iso,omitempty"`      // RFC3339 UTC
+	UpdatedAtIso   string                 `protobuf:"bytes,11,opt,name=updated_at_iso,json=updatedAtIso,proto3" json:"updated_at_iso,omitempty"`      // RFC3339 UTC
+	RefundedAmount int64                  `protobuf:"varint,12,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"` // รวมทุกครั้งที่คืน
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_payment_v1_payment_proto_rawDesc = "" +
-	"\n" +
-	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8b\x01\n" +
-	"\x17CreateCardChargeRequest\x12\x1d\n" +
-	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1d\n" +
-	"\n" +
-	"card_token\x18\x04 \x01(\tR\tcardToken\"t\n" +
-	"\x18CreateCardChargeResponse\x12\x1b\n" +
-	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
-	"\rauthorize_uri\x18\x03 \x01(\tR\fauthorizeUri\"\xcb\x01\n" +
-	"\x19CreateSourceChargeRequest\x12\x1d\n" +
-	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1b\n" +
-	"\tsource_id\x18\x04 \x01(\tR\bsourceId\x12\x1d\n" +
-	"\n" +
-	"return_uri\x18\x05 \x01(\tR\treturnUri\x12\x1f\n" +
-	"\vsource_type\x18\x06 \x01(\tR\n" +
-	"sourceType\"v\n" +
-	"\x1aCreateSourceChargeResponse\x12\x1b\n" +
-	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12#\n" +
-	"\rauthorize_uri\x18\x02 \x01(\tR\fauthorizeUri\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"/\n" +
-	"\x10GetChargeRequest\x12\x1b\n" +
-	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\"\x94\x01\n" +
-	"\x11GetChargeResponse\x12\x1b\n" +
-	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12!\n" +
-	"\ffailure_code\x18\x03 \x01(\tR\vfailureCode\x12'\n" +
-	"\x0ffailure_message\x18\x04 \x01(\tR\x0efailureMessage2\x9e\x02\n" +
-	"\x0ePaymentService\x12]\n" +
-	"\x10CreateCardCharge\x12#.payment.v1.CreateCardChargeRequest\x1a$.payment.v1.CreateCardChargeResponse\x12c\n" +
-	"\x12CreateSourceCharge\x12%.payment.v1.CreateSourceChargeRequest\x1a&.payment.v1.CreateSourceChargeResponse\x12H\n" +
-	"\tGetCharge\x12\x1c.payment.v1.GetChargeRequest\x1a\x1d.payment.v1.GetChargeResponseB=Z;github.com/you/badminton-booking/proto/payment/v1;paymentv1b\x06proto3"
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Payment) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *Payment) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Payment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+func (x *Payment) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAtIso() string {
+	if x != nil {
+		return x.CreatedAtIso
+	}
+	return ""
+}
+
+func (x *Payment) GetUpdatedAtIso() string {
+	if x != nil {
+		return x.UpdatedAtIso
+	}
+	return ""
+}
+
+func (x *Payment) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+type ListPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                           // 0-based
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default 20
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // optional filter
+	BookingId     string                 `protobuf:"bytes,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"` // optional filter
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPaymentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetPaymentsByBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentsByBookingRequest) Reset() {
+	*x = GetPaymentsByBookingRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentsByBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentsByBookingRequest) ProtoMessage() {}
+
+func (x *GetPaymentsByBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentsByBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentsByBookingRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPaymentsByBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetPaymentsByBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentsByBookingResponse) Reset() {
+	*x = GetPaymentsByBookingResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentsByBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentsByBookingResponse) ProtoMessage() {}
+
+func (x *GetPaymentsByBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentsByBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentsByBookingResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPaymentsByBookingResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type RefundChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // 0 = คืนเต็มยอดที่เหลือ
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundChargeRequest) Reset() {
+	*x = RefundChargeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundChargeRequest) ProtoMessage() {}
+
+func (x *RefundChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundChargeRequest.ProtoReflect.Descriptor instead.
+func (*RefundChargeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefundChargeRequest) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *RefundChargeRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundChargeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RefundChargeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	ChargeId      string                 `protobuf:"bytes,2,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundChargeResponse) Reset() {
+	*x = RefundChargeResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundChargeResponse) ProtoMessage() {}
+
+func (x *RefundChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundChargeResponse.ProtoReflect.Descriptor instead.
+func (*RefundChargeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefundChargeResponse) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundChargeResponse) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *RefundChargeResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundChargeResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// ReconciliationItem ความคลาดเคลื่อนหนึ่งรายการระหว่าง gateway / ledger / booking
+type ReconciliationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // missing_in_ledger, status_mismatch, booking_not_confirmed, duplicate_payment, ...
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"` // สิ่งที่ระบบแก้ไปแล้ว; ว่าง = ต้องให้คนตรวจ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationItem) Reset() {
+	*x = ReconciliationItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationItem) ProtoMessage() {}
+
+func (x *ReconciliationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationItem.ProtoReflect.Descriptor instead.
+func (*ReconciliationItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReconciliationItem) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReconciliationReportRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type GetReconciliationReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RunId          string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WindowFromIso  string                 `protobuf:"bytes,2,opt,name=window_from_iso,json=windowFromIso,proto3" json:"window_from_iso,omitempty"` // ช่วงของ charge ที่ตรวจ (RFC3339 UTC)
+	WindowToIso    string                 `protobuf:"bytes,3,opt,name=window_to_iso,json=windowToIso,proto3" json:"window_to_iso,omitempty"`
+	StartedAtIso   string                 `protobuf:"bytes,4,opt,name=started_at_iso,json=startedAtIso,proto3" json:"started_at_iso,omitempty"`
+	FinishedAtIso  string                 `protobuf:"bytes,5,opt,name=finished_at_iso,json=finishedAtIso,proto3" json:"finished_at_iso,omitempty"`
+	ChargesChecked int32                  `protobuf:"varint,6,opt,name=charges_checked,json=chargesChecked,proto3" json:"charges_checked,omitempty"`
+	Error          string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // ดึงรายการจาก gateway ไม่สำเร็จ
+	Items          []*ReconciliationItem  `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetReconciliationReportResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetWindowFromIso() string {
+	if x != nil {
+		return x.WindowFromIso
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetWindowToIso() string {
+	if x != nil {
+		return x.WindowToIso
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetStartedAtIso() string {
+	if x != nil {
+		return x.StartedAtIso
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetFinishedAtIso() string {
+	if x != nil {
+		return x.FinishedAtIso
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetChargesChecked() int32 {
+	if x != nil {
+		return x.ChargesChecked
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportResponse) GetItems() []*ReconciliationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type WatchChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChargeRequest) Reset() {
+	*x = WatchChargeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChargeRequest) ProtoMessage() {}
+
+func (x *WatchChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChargeRequest.ProtoReflect.Descriptor instead.
+func (*WatchChargeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchChargeRequest) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+type ChargeStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId       string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	BookingId      string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	FailureCode    string                 `protobuf:"bytes,4,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	FailureMessage string                 `protobuf:"bytes,5,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	UpdatedAtIso   string                 `protobuf:"bytes,6,opt,name=updated_at_iso,json=updatedAtIso,proto3" json:"updated_at_iso,omitempty"` // RFC3339 UTC
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChargeStatusEvent) Reset() {
+	*x = ChargeStatusEvent{}
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeStatusEvent) ProtoMessage() {}
+
+func (x *ChargeStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeStatusEvent.ProtoReflect.Descriptor instead.
+func (*ChargeStatusEvent) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChargeStatusEvent) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *ChargeStatusEvent) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ChargeStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChargeStatusEvent) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+func (x *ChargeStatusEvent) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+func (x *ChargeStatusEvent) GetUpdatedAtIso() string {
+	if x != nil {
+		return x.UpdatedAtIso
+	}
+	return ""
+}
+
+// Payout ใบสรุปยอดที่ต้องโอนให้เจ้าของสนามหนึ่งคนในงวด [period_start, period_end)
+type Payout struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId           string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	PeriodStartIso    string                 `protobuf:"bytes,3,opt,name=period_start_iso,json=periodStartIso,proto3" json:"period_start_iso,omitempty"` // RFC3339 UTC
+	PeriodEndIso      string                 `protobuf:"bytes,4,opt,name=period_end_iso,json=periodEndIso,proto3" json:"period_end_iso,omitempty"`
+	Currency          string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Gross             int64                  `protobuf:"varint,6,opt,name=gross,proto3" json:"gross,omitempty"`     // ยอดจ่ายสำเร็จ (สตางค์)
+	Refunds           int64                  `protobuf:"varint,7,opt,name=refunds,proto3" json:"refunds,omitempty"` // ยอดคืนเงินที่เกิดในงวด
+	CommissionPercent float64                `protobuf:"fixed64,8,opt,name=commission_percent,json=commissionPercent,proto3" json:"commission_percent,omitempty"`
+	Commission        int64                  `protobuf:"varint,9,opt,name=commission,proto3" json:"commission,omitempty"`
+	Net               int64                  `protobuf:"varint,10,opt,name=net,proto3" json:"net,omitempty"`      // gross - refunds - commission (ติดลบได้)
+	Status            string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // PENDING / PAID
+	PaidAtIso         string                 `protobuf:"bytes,12,opt,name=paid_at_iso,json=paidAtIso,proto3" json:"paid_at_iso,omitempty"`
+	PaidRef           string                 `protobuf:"bytes,13,opt,name=paid_ref,json=paidRef,proto3" json:"paid_ref,omitempty"`
+	CreatedAtIso      string                 `protobuf:"bytes,14,opt,name=created_at_iso,json=createdAtIso,proto3" json:"created_at_iso,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payout) ProtoMessage() {}
+
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Payout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payout) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Payout) GetPeriodStartIso() string {
+	if x != nil {
+		return x.PeriodStartIso
+	}
+	return ""
+}
+
+func (x *Payout) GetPeriodEndIso() string {
+	if x != nil {
+		return x.PeriodEndIso
+	}
+	return ""
+}
+
+func (x *Payout) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payout) GetGross() int64 {
+	if x != nil {
+		return x.Gross
+	}
+	return 0
+}
+
+func (x *Payout) GetRefunds() int64 {
+	if x != nil {
+		return x.Refunds
+	}
+	return 0
+}
+
+func (x *Payout) GetCommissionPercent() float64 {
+	if x != nil {
+		return x.CommissionPercent
+	}
+	return 0
+}
+
+func (x *Payout) GetCommission() int64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *Payout) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *Payout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payout) GetPaidAtIso() string {
+	if x != nil {
+		return x.PaidAtIso
+	}
+	return ""
+}
+
+func (x *Payout) GetPaidRef() string {
+	if x != nil {
+		return x.PaidRef
+	}
+	return ""
+}
+
+func (x *Payout) GetCreatedAtIso() string {
+	if x != nil {
+		return x.CreatedAtIso
+	}
+	return ""
+}
+
+// PayoutLine charge หรือ refund หนึ่งรายการในใบสรุป
+type PayoutLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                // charge / refund
+	RefId         string                 `protobuf:"bytes,2,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"` // charge id หรือ refund id
+	ChargeId      string                 `protobuf:"bytes,3,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CourtId       string                 `protobuf:"bytes,5,opt,name=court_id,json=courtId,proto3" json:"court_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAtIso string                 `protobuf:"bytes,7,opt,name=occurred_at_iso,json=occurredAtIso,proto3" json:"occurred_at_iso,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutLine) Reset() {
+	*x = PayoutLine{}
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutLine) ProtoMessage() {}
+
+func (x *PayoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutLine.ProtoReflect.Descriptor instead.
+func (*PayoutLine) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PayoutLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetCourtId() string {
+	if x != nil {
+		return x.CourtId
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayoutLine) GetOccurredAtIso() string {
+	if x != nil {
+		return x.OccurredAtIso
+	}
+	return ""
+}
+
+type ListPayoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 0-based
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 20
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`     // optional filter
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                      // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsRequest) Reset() {
+	*x = ListPayoutsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest) ProtoMessage() {}
+
+func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPayoutsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListPayoutsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListPayoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*Payout              `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsResponse) Reset() {
+	*x = ListPayoutsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsResponse) ProtoMessage() {}
+
+func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListPayoutsResponse) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ListPayoutsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetPayoutStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutStatementRequest) Reset() {
+	*x = GetPayoutStatementRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutStatementRequest) ProtoMessage() {}
+
+func (x *GetPayoutStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutStatementRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPayoutStatementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPayoutStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payout        *Payout                `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	Lines         []*PayoutLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutStatementResponse) Reset() {
+	*x = GetPayoutStatementResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutStatementResponse) ProtoMessage() {}
+
+func (x *GetPayoutStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutStatementResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPayoutStatementResponse) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+func (x *GetPayoutStatementResponse) GetLines() []*PayoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type MarkPayoutPaidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // เลขอ้างอิงการโอน
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPayoutPaidRequest) Reset() {
+	*x = MarkPayoutPaidRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPayoutPaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPayoutPaidRequest) ProtoMessage() {}
+
+func (x *MarkPayoutPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPayoutPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkPayoutPaidRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MarkPayoutPaidRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkPayoutPaidRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type MarkPayoutPaidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payout        *Payout                `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPayoutPaidResponse) Reset() {
+	*x = MarkPayoutPaidResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPayoutPaidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPayoutPaidResponse) ProtoMessage() {}
+
+func (x *MarkPayoutPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPayoutPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkPayoutPaidResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarkPayoutPaidResponse) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+// ---------- Wallet (ยอดเงินเติมล่วงหน้า) ----------
+type Wallet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`  // สตางค์
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // ว่าง = ยังไม่เคยเติม
+	UpdatedAtIso  string                 `protobuf:"bytes,4,opt,name=updated_at_iso,json=updatedAtIso,proto3" json:"updated_at_iso,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Wallet) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Wallet) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Wallet) GetUpdatedAtIso() string {
+	if x != nil {
+		return x.UpdatedAtIso
+	}
+	return ""
+}
+
+// WalletTransaction amount บวก = เงินเข้า, ลบ = เงินออก
+type WalletTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // TOPUP / PAYMENT / REFUND
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter  int64                  `protobuf:"varint,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	ChargeId      string                 `protobuf:"bytes,6,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"` // TOPUP: Omise charge; PAYMENT/REFUND: payment id ของ wallet
+	BookingId     string                 `protobuf:"bytes,7,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CreatedAtIso  string                 `protobuf:"bytes,8,opt,name=created_at_iso,json=createdAtIso,proto3" json:"created_at_iso,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_payment_v1_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WalletTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetCreatedAtIso() string {
+	if x != nil {
+		return x.CreatedAtIso
+	}
+	return ""
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetWalletResponse) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// TopUpRequest ส่ง card_token หรือ source_type อย่างใดอย่างหนึ่ง
+type TopUpRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	CardToken      string                 `protobuf:"bytes,4,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	SourceType     string                 `protobuf:"bytes,5,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // เช่น "promptpay"
+	ReturnUri      string                 `protobuf:"bytes,6,opt,name=return_uri,json=returnUri,proto3" json:"return_uri,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // จาก header Idempotency-Key
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TopUpRequest) Reset() {
+	*x = TopUpRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpRequest) ProtoMessage() {}
+
+func (x *TopUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpRequest.ProtoReflect.Descriptor instead.
+func (*TopUpRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TopUpRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetReturnUri() string {
+	if x != nil {
+		return x.ReturnUri
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type TopUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // successful = เข้า wallet แล้ว; pending = เข้าเมื่อ charge สำเร็จ
+	AuthorizeUri  string                 `protobuf:"bytes,3,opt,name=authorize_uri,json=authorizeUri,proto3" json:"authorize_uri,omitempty"`
+	QrImageUri    string                 `protobuf:"bytes,4,opt,name=qr_image_uri,json=qrImageUri,proto3" json:"qr_image_uri,omitempty"`
+	ExpiresAtIso  string                 `protobuf:"bytes,5,opt,name=expires_at_iso,json=expiresAtIso,proto3" json:"expires_at_iso,omitempty"`
+	Wallet        *Wallet                `protobuf:"bytes,6,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpResponse) Reset() {
+	*x = TopUpResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpResponse) ProtoMessage() {}
+
+func (x *TopUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpResponse.ProtoReflect.Descriptor instead.
+func (*TopUpResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TopUpResponse) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *TopUpResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TopUpResponse) GetAuthorizeUri() string {
+	if x != nil {
+		return x.AuthorizeUri
+	}
+	return ""
+}
+
+func (x *TopUpResponse) GetQrImageUri() string {
+	if x != nil {
+		return x.QrImageUri
+	}
+	return ""
+}
+
+func (x *TopUpResponse) GetExpiresAtIso() string {
+	if x != nil {
+		return x.ExpiresAtIso
+	}
+	return ""
+}
+
+func (x *TopUpResponse) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type ListWalletTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 0-based
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsRequest) Reset() {
+	*x = ListWalletTransactionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsRequest) ProtoMessage() {}
+
+func (x *ListWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListWalletTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWalletTransactionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWalletTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWalletTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsResponse) Reset() {
+	*x = ListWalletTransactionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsResponse) ProtoMessage() {}
+
+func (x *ListWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListWalletTransactionsResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListWalletTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// PayWithWallet ตัด wallet เท่ากับราคา booking แล้วส่ง payment.paid เหมือนจ่ายผ่าน Omise
+type PayWithWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayWithWalletRequest) Reset() {
+	*x = PayWithWalletRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayWithWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayWithWalletRequest) ProtoMessage() {}
+
+func (x *PayWithWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayWithWalletRequest.ProtoReflect.Descriptor instead.
+func (*PayWithWalletRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PayWithWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PayWithWalletRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type PayWithWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Wallet        *Wallet                `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayWithWalletResponse) Reset() {
+	*x = PayWithWalletResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayWithWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayWithWalletResponse) ProtoMessage() {}
+
+func (x *PayWithWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayWithWalletResponse.ProtoReflect.Descriptor instead.
+func (*PayWithWalletResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PayWithWalletResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PayWithWalletResponse) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *PayWithWalletResponse) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// ---------- Receipt (ใบเสร็จ / ใบกำกับภาษีอย่างย่อ) ----------
+type Receipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"` // เช่น RC2026-000123
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // RECEIPT / TAX_INVOICE
+	ChargeId      string                 `protobuf:"bytes,3,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Venue         string                 `protobuf:"bytes,6,opt,name=venue,proto3" json:"venue,omitempty"`
+	CourtNo       int32                  `protobuf:"varint,7,opt,name=court_no,json=courtNo,proto3" json:"court_no,omitempty"`
+	StartIso      string                 `protobuf:"bytes,8,opt,name=start_iso,json=startIso,proto3" json:"start_iso,omitempty"`
+	EndIso        string                 `protobuf:"bytes,9,opt,name=end_iso,json=endIso,proto3" json:"end_iso,omitempty"`
+	Amount        int64                  `protobuf:"varint,10,opt,name=amount,proto3" json:"amount,omitempty"` // รวม VAT (สตางค์)
+	VatPercent    float64                `protobuf:"fixed64,11,opt,name=vat_percent,json=vatPercent,proto3" json:"vat_percent,omitempty"`
+	VatAmount     int64                  `protobuf:"varint,12,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	Method        string                 `protobuf:"bytes,14,opt,name=method,proto3" json:"method,omitempty"`
+	PaidAtIso     string                 `protobuf:"bytes,15,opt,name=paid_at_iso,json=paidAtIso,proto3" json:"paid_at_iso,omitempty"`
+	IssuedAtIso   string                 `protobuf:"bytes,16,opt,name=issued_at_iso,json=issuedAtIso,proto3" json:"issued_at_iso,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_payment_v1_payment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Receipt) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Receipt) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Receipt) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *Receipt) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Receipt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Receipt) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Receipt) GetCourtNo() int32 {
+	if x != nil {
+		return x.CourtNo
+	}
+	return 0
+}
+
+func (x *Receipt) GetStartIso() string {
+	if x != nil {
+		return x.StartIso
+	}
+	return ""
+}
+
+func (x *Receipt) GetEndIso() string {
+	if x != nil {
+		return x.EndIso
+	}
+	return ""
+}
+
+func (x *Receipt) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Receipt) GetVatPercent() float64 {
+	if x != nil {
+		return x.VatPercent
+	}
+	return 0
+}
+
+func (x *Receipt) GetVatAmount() int64 {
+	if x != nil {
+		return x.VatAmount
+	}
+	return 0
+}
+
+func (x *Receipt) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Receipt) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Receipt) GetPaidAtIso() string {
+	if x != nil {
+		return x.PaidAtIso
+	}
+	return ""
+}
+
+func (x *Receipt) GetIssuedAtIso() string {
+	if x != nil {
+		return x.IssuedAtIso
+	}
+	return ""
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId      string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetReceiptRequest) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Pdf           []byte                 `protobuf:"bytes,2,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *GetReceiptResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+var File_payment_v1_payment_proto protoreflect.FileDescriptor
+
+const file_payment_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18payment/v1/payment.proto\x12\n" +
+	"payment.v1\"\xcf\x01\n" +
+	"\x17CreateCardChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x04 \x01(\tR\tcardToken\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12\x19\n" +
+	"\bshare_id\x18\x06 \x01(\tR\ashareId\"t\n" +
+	"\x18CreateCardChargeResponse\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rauthorize_uri\x18\x03 \x01(\tR\fauthorizeUri\"\x8f\x02\n" +
+	"\x19CreateSourceChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\tR\bsourceId\x12\x1d\n" +
+	"\n" +
+	"return_uri\x18\x05 \x01(\tR\treturnUri\x12\x1f\n" +
+	"\vsource_type\x18\x06 \x01(\tR\n" +
+	"sourceType\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12\x19\n" +
+	"\bshare_id\x18\b \x01(\tR\ashareId\"\xbe\x01\n" +
+	"\x1aCreateSourceChargeResponse\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12#\n" +
+	"\rauthorize_uri\x18\x02 \x01(\tR\fauthorizeUri\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12 \n" +
+	"\fqr_image_uri\x18\x04 \x01(\tR\n" +
+	"qrImageUri\x12$\n" +
+	"\x0eexpires_at_iso\x18\x05 \x01(\tR\fexpiresAtIso\"/\n" +
+	"\x10GetChargeRequest\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\"\x94\x01\n" +
+	"\x11GetChargeResponse\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12!\n" +
+	"\ffailure_code\x18\x03 \x01(\tR\vfailureCode\x12'\n" +
+	"\x0ffailure_message\x18\x04 \x01(\tR\x0efailureMessage\"\x83\x03\n" +
+	"\aPayment\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\ffailure_code\x18\b \x01(\tR\vfailureCode\x12'\n" +
+	"\x0ffailure_message\x18\t \x01(\tR\x0efailureMessage\x12$\n" +
+	"\x0ecreated_at_iso\x18\n" +
+	" \x01(\tR\fcreatedAtIso\x12$\n" +
+	"\x0eupdated_at_iso\x18\v \x01(\tR\fupdatedAtIso\x12'\n" +
+	"\x0frefunded_amount\x18\f \x01(\x03R\x0erefundedAmount\"\x96\x01\n" +
+	"\x13ListPaymentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"]\n" +
+	"\x14ListPaymentsResponse\x12/\n" +
+	"\bpayments\x18\x01 \x03(\v2\x13.payment.v1.PaymentR\bpayments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"<\n" +
+	"\x1bGetPaymentsByBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"O\n" +
+	"\x1cGetPaymentsByBookingResponse\x12/\n" +
+	"\bpayments\x18\x01 \x03(\v2\x13.payment.v1.PaymentR\bpayments\"b\n" +
+	"\x13RefundChargeRequest\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x14RefundChargeResponse\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12\x1b\n" +
+	"\tcharge_id\x18\x02 \x01(\tR\bchargeId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\x94\x01\n" +
+	"\x12ReconciliationItem\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\"7\n" +
+	"\x1eGetReconciliationReportRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\xc7\x02\n" +
+	"\x1fGetReconciliationReportResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12&\n" +
+	"\x0fwindow_from_iso\x18\x02 \x01(\tR\rwindowFromIso\x12\"\n" +
+	"\rwindow_to_iso\x18\x03 \x01(\tR\vwindowToIso\x12$\n" +
+	"\x0estarted_at_iso\x18\x04 \x01(\tR\fstartedAtIso\x12&\n" +
+	"\x0ffinished_at_iso\x18\x05 \x01(\tR\rfinishedAtIso\x12'\n" +
+	"\x0fcharges_checked\x18\x06 \x01(\x05R\x0echargesChecked\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x124\n" +
+	"\x05items\x18\b \x03(\v2\x1e.payment.v1.ReconciliationItemR\x05items\"1\n" +
+	"\x12WatchChargeRequest\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\"\xd9\x01\n" +
+	"\x11ChargeStatusEvent\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\ffailure_code\x18\x04 \x01(\tR\vfailureCode\x12'\n" +
+	"\x0ffailure_message\x18\x05 \x01(\tR\x0efailureMessage\x12$\n" +
+	"\x0eupdated_at_iso\x18\x06 \x01(\tR\fupdatedAtIso\"\xa9\x03\n" +
+	"\x06Payout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12(\n" +
+	"\x10period_start_iso\x18\x03 \x01(\tR\x0eperiodStartIso\x12$\n" +
+	"\x0eperiod_end_iso\x18\x04 \x01(\tR\fperiodEndIso\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05gross\x18\x06 \x01(\x03R\x05gross\x12\x18\n" +
+	"\arefunds\x18\a \x01(\x03R\arefunds\x12-\n" +
+	"\x12commission_percent\x18\b \x01(\x01R\x11commissionPercent\x12\x1e\n" +
+	"\n" +
+	"commission\x18\t \x01(\x03R\n" +
+	"commission\x12\x10\n" +
+	"\x03net\x18\n" +
+	" \x01(\x03R\x03net\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1e\n" +
+	"\vpaid_at_iso\x18\f \x01(\tR\tpaidAtIso\x12\x19\n" +
+	"\bpaid_ref\x18\r \x01(\tR\apaidRef\x12$\n" +
+	"\x0ecreated_at_iso\x18\x0e \x01(\tR\fcreatedAtIso\"\xce\x01\n" +
+	"\n" +
+	"PayoutLine\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x15\n" +
+	"\x06ref_id\x18\x02 \x01(\tR\x05refId\x12\x1b\n" +
+	"\tcharge_id\x18\x03 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\tR\tbookingId\x12\x19\n" +
+	"\bcourt_id\x18\x05 \x01(\tR\acourtId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12&\n" +
+	"\x0foccurred_at_iso\x18\a \x01(\tR\roccurredAtIso\"x\n" +
+	"\x12ListPayoutsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"Y\n" +
+	"\x13ListPayoutsResponse\x12,\n" +
+	"\apayouts\x18\x01 \x03(\v2\x12.payment.v1.PayoutR\apayouts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"+\n" +
+	"\x19GetPayoutStatementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"v\n" +
+	"\x1aGetPayoutStatementResponse\x12*\n" +
+	"\x06payout\x18\x01 \x01(\v2\x12.payment.v1.PayoutR\x06payout\x12,\n" +
+	"\x05lines\x18\x02 \x03(\v2\x16.payment.v1.PayoutLineR\x05lines\"E\n" +
+	"\x15MarkPayoutPaidRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\"D\n" +
+	"\x16MarkPayoutPaidResponse\x12*\n" +
+	"\x06payout\x18\x01 \x01(\v2\x12.payment.v1.PayoutR\x06payout\"}\n" +
+	"\x06Wallet\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12$\n" +
+	"\x0eupdated_at_iso\x18\x04 \x01(\tR\fupdatedAtIso\"\xf2\x01\n" +
+	"\x11WalletTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x03R\fbalanceAfter\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tcharge_id\x18\x06 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\a \x01(\tR\tbookingId\x12$\n" +
+	"\x0ecreated_at_iso\x18\b \x01(\tR\fcreatedAtIso\"+\n" +
+	"\x10GetWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"?\n" +
+	"\x11GetWalletResponse\x12*\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x12.payment.v1.WalletR\x06wallet\"\xe3\x01\n" +
+	"\fTopUpRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x04 \x01(\tR\tcardToken\x12\x1f\n" +
+	"\vsource_type\x18\x05 \x01(\tR\n" +
+	"sourceType\x12\x1d\n" +
+	"\n" +
+	"return_uri\x18\x06 \x01(\tR\treturnUri\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\xdd\x01\n" +
+	"\rTopUpResponse\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rauthorize_uri\x18\x03 \x01(\tR\fauthorizeUri\x12 \n" +
+	"\fqr_image_uri\x18\x04 \x01(\tR\n" +
+	"qrImageUri\x12$\n" +
+	"\x0eexpires_at_iso\x18\x05 \x01(\tR\fexpiresAtIso\x12*\n" +
+	"\x06wallet\x18\x06 \x01(\v2\x12.payment.v1.WalletR\x06wallet\"i\n" +
+	"\x1dListWalletTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"y\n" +
+	"\x1eListWalletTransactionsResponse\x12A\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1d.payment.v1.WalletTransactionR\ftransactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"N\n" +
+	"\x14PayWithWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\"\xa3\x01\n" +
+	"\x15PayWithWalletResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12?\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1d.payment.v1.WalletTransactionR\vtransaction\x12*\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x12.payment.v1.WalletR\x06wallet\"\xc1\x03\n" +
+	"\aReceipt\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tcharge_id\x18\x03 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05venue\x18\x06 \x01(\tR\x05venue\x12\x19\n" +
+	"\bcourt_no\x18\a \x01(\x05R\acourtNo\x12\x1b\n" +
+	"\tstart_iso\x18\b \x01(\tR\bstartIso\x12\x17\n" +
+	"\aend_iso\x18\t \x01(\tR\x06endIso\x12\x16\n" +
+	"\x06amount\x18\n" +
+	" \x01(\x03R\x06amount\x12\x1f\n" +
+	"\vvat_percent\x18\v \x01(\x01R\n" +
+	"vatPercent\x12\x1d\n" +
+	"\n" +
+	"vat_amount\x18\f \x01(\x03R\tvatAmount\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06method\x18\x0e \x01(\tR\x06method\x12\x1e\n" +
+	"\vpaid_at_iso\x18\x0f \x01(\tR\tpaidAtIso\x12\"\n" +
+	"\rissued_at_iso\x18\x10 \x01(\tR\vissuedAtIso\"0\n" +
+	"\x11GetReceiptRequest\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\"U\n" +
+	"\x12GetReceiptResponse\x12-\n" +
+	"\areceipt\x18\x01 \x01(\v2\x13.payment.v1.ReceiptR\areceipt\x12\x10\n" +
+	"\x03pdf\x18\x02 \x01(\fR\x03pdf2\x9d\v\n" +
+	"\x0ePaymentService\x12]\n" +
+	"\x10CreateCardCharge\x12#.payment.v1.CreateCardChargeRequest\x1a$.payment.v1.CreateCardChargeResponse\x12c\n" +
+	"\x12CreateSourceCharge\x12%.payment.v1.CreateSourceChargeRequest\x1a&.payment.v1.CreateSourceChargeResponse\x12H\n" +
+	"\tGetCharge\x12\x1c.payment.v1.GetChargeRequest\x1a\x1d.payment.v1.GetChargeResponse\x12Q\n" +
+	"\fListPayments\x12\x1f.payment.v1.ListPaymentsRequest\x1a .payment.v1.ListPaymentsResponse\x12i\n" +
+	"\x14GetPaymentsByBooking\x12'.payment.v1.GetPaymentsByBookingRequest\x1a(.payment.v1.GetPaymentsByBookingResponse\x12Q\n" +
+	"\fRefundCharge\x12\x1f.payment.v1.RefundChargeRequest\x1a .payment.v1.RefundChargeResponse\x12r\n" +
+	"\x17GetReconciliationReport\x12*.payment.v1.GetReconciliationReportRequest\x1a+.payment.v1.GetReconciliationReportResponse\x12N\n" +
+	"\vWatchCharge\x12\x1e.payment.v1.WatchChargeRequest\x1a\x1d.payment.v1.ChargeStatusEvent0\x01\x12N\n" +
+	"\vListPayouts\x12\x1e.payment.v1.ListPayoutsRequest\x1a\x1f.payment.v1.ListPayoutsResponse\x12c\n" +
+	"\x12GetPayoutStatement\x12%.payment.v1.GetPayoutStatementRequest\x1a&.payment.v1.GetPayoutStatementResponse\x12W\n" +
+	"\x0eMarkPayoutPaid\x12!.payment.v1.MarkPayoutPaidRequest\x1a\".payment.v1.MarkPayoutPaidResponse\x12H\n" +
+	"\tGetWallet\x12\x1c.payment.v1.GetWalletRequest\x1a\x1d.payment.v1.GetWalletResponse\x12<\n" +
+	"\x05TopUp\x12\x18.payment.v1.TopUpRequest\x1a\x19.payment.v1.TopUpResponse\x12o\n" +
+	"\x16ListWalletTransactions\x12).payment.v1.ListWalletTransactionsRequest\x1a*.payment.v1.ListWalletTransactionsResponse\x12T\n" +
+	"\rPayWithWallet\x12 .payment.v1.PayWithWalletRequest\x1a!.payment.v1.PayWithWalletResponse\x12K\n" +
+	"\n" +
+	"GetReceipt\x12\x1d.payment.v1.GetReceiptRequest\x1a\x1e.payment.v1.GetReceiptResponseB=Z;github.com/you/badminton-booking/proto/payment/v1;paymentv1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -461,27 +3025,99 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(*CreateCardChargeRequest)(nil),    // 0: payment.v1.CreateCardChargeRequest
-	(*CreateCardChargeResponse)(nil),   // 1: payment.v1.CreateCardChargeResponse
-	(*CreateSourceChargeRequest)(nil),  // 2: payment.v1.CreateSourceChargeRequest
-	(*CreateSourceChargeResponse)(nil), // 3: payment.v1.CreateSourceChargeResponse
-	(*GetChargeRequest)(nil),           // 4: payment.v1.GetChargeRequest
-	(*GetChargeResponse)(nil),          // 5: payment.v1.GetChargeResponse
+	(*CreateCardChargeRequest)(nil),         // 0: payment.v1.CreateCardChargeRequest
+	(*CreateCardChargeResponse)(nil),        // 1: payment.v1.CreateCardChargeResponse
+	(*CreateSourceChargeRequest)(nil),       // 2: payment.v1.CreateSourceChargeRequest
+	(*CreateSourceChargeResponse)(nil),      // 3: payment.v1.CreateSourceChargeResponse
+	(*GetChargeRequest)(nil),                // 4: payment.v1.GetChargeRequest
+	(*GetChargeResponse)(nil),               // 5: payment.v1.GetChargeResponse
+	(*Payment)(nil),                         // 6: payment.v1.Payment
+	(*ListPaymentsRequest)(nil),             // 7: payment.v1.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),            // 8: payment.v1.ListPaymentsResponse
+	(*GetPaymentsByBookingRequest)(nil),     // 9: payment.v1.GetPaymentsByBookingRequest
+	(*GetPaymentsByBookingResponse)(nil),    // 10: payment.v1.GetPaymentsByBookingResponse
+	(*RefundChargeRequest)(nil),             // 11: payment.v1.RefundChargeRequest
+	(*RefundChargeResponse)(nil),            // 12: payment.v1.RefundChargeResponse
+	(*ReconciliationItem)(nil),              // 13: payment.v1.ReconciliationItem
+	(*GetReconciliationReportRequest)(nil),  // 14: payment.v1.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil), // 15: payment.v1.GetReconciliationReportResponse
+	(*WatchChargeRequest)(nil),              // 16: payment.v1.WatchChargeRequest
+	(*ChargeStatusEvent)(nil),               // 17: payment.v1.ChargeStatusEvent
+	(*Payout)(nil),                          // 18: payment.v1.Payout
+	(*PayoutLine)(nil),                      // 19: payment.v1.PayoutLine
+	(*ListPayoutsRequest)(nil),              // 20: payment.v1.ListPayoutsRequest
+	(*ListPayoutsResponse)(nil),             // 21: payment.v1.ListPayoutsResponse
+	(*GetPayoutStatementRequest)(nil),       // 22: payment.v1.GetPayoutStatementRequest
+	(*GetPayoutStatementResponse)(nil),      // 23: payment.v1.GetPayoutStatementResponse
+	(*MarkPayoutPaidRequest)(nil),           // 24: payment.v1.MarkPayoutPaidRequest
+	(*MarkPayoutPaidResponse)(nil),          // 25: payment.v1.MarkPayoutPaidResponse
+	(*Wallet)(nil),                          // 26: payment.v1.Wallet
+	(*WalletTransaction)(nil),               // 27: payment.v1.WalletTransaction
+	(*GetWalletRequest)(nil),                // 28: payment.v1.GetWalletRequest
+	(*GetWalletResponse)(nil),               // 29: payment.v1.GetWalletResponse
+	(*TopUpRequest)(nil),                    // 30: payment.v1.TopUpRequest
+	(*TopUpResponse)(nil),                   // 31: payment.v1.TopUpResponse
+	(*ListWalletTransactionsRequest)(nil),   // 32: payment.v1.ListWalletTransactionsRequest
+	(*ListWalletTransactionsResponse)(nil),  // 33: payment.v1.ListWalletTransactionsResponse
+	(*PayWithWalletRequest)(nil),            // 34: payment.v1.PayWithWalletRequest
+	(*PayWithWalletResponse)(nil),           // 35: payment.v1.PayWithWalletResponse
+	(*Receipt)(nil),                         // 36: payment.v1.Receipt
+	(*GetReceiptRequest)(nil),               // 37: payment.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),              // 38: payment.v1.GetReceiptResponse
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PaymentService.CreateCardCharge:input_type -> payment.v1.CreateCardChargeRequest
-	2, // 1: payment.v1.PaymentService.CreateSourceCharge:input_type -> payment.v1.CreateSourceChargeRequest
-	4, // 2: payment.v1.PaymentService.GetCharge:input_type -> payment.v1.GetChargeRequest
-	1, // 3: payment.v1.PaymentService.CreateCardCharge:output_type -> payment.v1.CreateCardChargeResponse
-	3, // 4: payment.v1.PaymentService.CreateSourceCharge:output_type -> payment.v1.CreateSourceChargeResponse
-	5, // 5: payment.v1.PaymentService.GetCharge:output_type -> payment.v1.GetChargeResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6,  // 0: payment.v1.ListPaymentsResponse.payments:type_name -> payment.v1.Payment
+	6,  // 1: payment.v1.GetPaymentsByBookingResponse.payments:type_name -> payment.v1.Payment
+	13, // 2: payment.v1.GetReconciliationReportResponse.items:type_name -> payment.v1.ReconciliationItem
+	18, // 3: payment.v1.ListPayoutsResponse.payouts:type_name -> payment.v1.Payout
+	18, // 4: payment.v1.GetPayoutStatementResponse.payout:type_name -> payment.v1.Payout
+	19, // 5: payment.v1.GetPayoutStatementResponse.lines:type_name -> payment.v1.PayoutLine
+	18, // 6: payment.v1.MarkPayoutPaidResponse.payout:type_name -> payment.v1.Payout
+	26, // 7: payment.v1.GetWalletResponse.wallet:type_name -> payment.v1.Wallet
+	26, // 8: payment.v1.TopUpResponse.wallet:type_name -> payment.v1.Wallet
+	27, // 9: payment.v1.ListWalletTransactionsResponse.transactions:type_name -> payment.v1.WalletTransaction
+	27, // 10: payment.v1.PayWithWalletResponse.transaction:type_name -> payment.v1.WalletTransaction
+	26, // 11: payment.v1.PayWithWalletResponse.wallet:type_name -> payment.v1.Wallet
+	36, // 12: payment.v1.GetReceiptResponse.receipt:type_name -> payment.v1.Receipt
+	0,  // 13: payment.v1.PaymentService.CreateCardCharge:input_type -> payment.v1.CreateCardChargeRequest
+	2,  // 14: payment.v1.PaymentService.CreateSourceCharge:input_type -> payment.v1.CreateSourceChargeRequest
+	4,  // 15: payment.v1.PaymentService.GetCharge:input_type -> payment.v1.GetChargeRequest
+	7,  // 16: payment.v1.PaymentService.ListPayments:input_type -> payment.v1.ListPaymentsRequest
+	9,  // 17: payment.v1.PaymentService.GetPaymentsByBooking:input_type -> payment.v1.GetPaymentsByBookingRequest
+	11, // 18: payment.v1.PaymentService.RefundCharge:input_type -> payment.v1.RefundChargeRequest
+	14, // 19: payment.v1.PaymentService.GetReconciliationReport:input_type -> payment.v1.GetReconciliationReportRequest
+	16, // 20: payment.v1.PaymentService.WatchCharge:input_type -> payment.v1.WatchChargeRequest
+	20, // 21: payment.v1.PaymentService.ListPayouts:input_type -> payment.v1.ListPayoutsRequest
+	22, // 22: payment.v1.PaymentService.GetPayoutStatement:input_type -> payment.v1.GetPayoutStatementRequest
+	24, // 23: payment.v1.PaymentService.MarkPayoutPaid:input_type -> payment.v1.MarkPayoutPaidRequest
+	28, // 24: payment.v1.PaymentService.GetWallet:input_type -> payment.v1.GetWalletRequest
+	30, // 25: payment.v1.PaymentService.TopUp:input_type -> payment.v1.TopUpRequest
+	32, // 26: payment.v1.PaymentService.ListWalletTransactions:input_type -> payment.v1.ListWalletTransactionsRequest
+	34, // 27: payment.v1.PaymentService.PayWithWallet:input_type -> payment.v1.PayWithWalletRequest
+	37, // 28: payment.v1.PaymentService.GetReceipt:input_type -> payment.v1.GetReceiptRequest
+	1,  // 29: payment.v1.PaymentService.CreateCardCharge:output_type -> payment.v1.CreateCardChargeResponse
+	3,  // 30: payment.v1.PaymentService.CreateSourceCharge:output_type -> payment.v1.CreateSourceChargeResponse
+	5,  // 31: payment.v1.PaymentService.GetCharge:output_type -> payment.v1.GetChargeResponse
+	8,  // 32: payment.v1.PaymentService.ListPayments:output_type -> payment.v1.ListPaymentsResponse
+	10, // 33: payment.v1.PaymentService.GetPaymentsByBooking:output_type -> payment.v1.GetPaymentsByBookingResponse
+	12, // 34: payment.v1.PaymentService.RefundCharge:output_type -> payment.v1.RefundChargeResponse
+	15, // 35: payment.v1.PaymentService.GetReconciliationReport:output_type -> payment.v1.GetReconciliationReportResponse
+	17, // 36: payment.v1.PaymentService.WatchCharge:output_type -> payment.v1.ChargeStatusEvent
+	21, // 37: payment.v1.PaymentService.ListPayouts:output_type -> payment.v1.ListPayoutsResponse
+	23, // 38: payment.v1.PaymentService.GetPayoutStatement:output_type -> payment.v1.GetPayoutStatementResponse
+	25, // 39: payment.v1.PaymentService.MarkPayoutPaid:output_type -> payment.v1.MarkPayoutPaidResponse
+	29, // 40: payment.v1.PaymentService.GetWallet:output_type -> payment.v1.GetWalletResponse
+	31, // 41: payment.v1.PaymentService.TopUp:output_type -> payment.v1.TopUpResponse
+	33, // 42: payment.v1.PaymentService.ListWalletTransactions:output_type -> payment.v1.ListWalletTransactionsResponse
+	35, // 43: payment.v1.PaymentService.PayWithWallet:output_type -> payment.v1.PayWithWalletResponse
+	38, // 44: payment.v1.PaymentService.GetReceipt:output_type -> payment.v1.GetReceiptResponse
+	29, // [29:45] is the sub-list for method output_type
+	13, // [13:29] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -495,7 +3131,7 @@ func file_payment_v1_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_CreateCardCharge_FullMethodName   = "/payment.v1.PaymentService/CreateCardCharge"
-	PaymentService_CreateSourceCharge_FullMethodName = "/payment.v1.PaymentService/CreateSourceCharge"
-	PaymentService_GetCharge_FullMethodName          = "/payment.v1.PaymentService/GetCharge"
+	PaymentService_CreateCardCharge_FullMethodName        = "/payment.v1.PaymentService/CreateCardCharge"
+	PaymentService_CreateSourceCharge_FullMethodName      = "/payment.v1.PaymentService/CreateSourceCharge"
+	PaymentService_GetCharge_FullMethodName               = "/payment.v1.PaymentService/GetCharge"
+	PaymentService_ListPayments_FullMethodName            = "/payment.v1.PaymentService/ListPayments"
+	PaymentService_GetPaymentsByBooking_FullMethodName    = "/payment.v1.PaymentService/GetPaymentsByBooking"
+	PaymentService_RefundCharge_FullMethodName            = "/payment.v1.PaymentService/RefundCharge"
+	PaymentService_GetReconciliationReport_FullMethodName = "/payment.v1.PaymentService/GetReconciliationReport"
+	PaymentService_WatchCharge_FullMethodName             = "/payment.v1.PaymentService/WatchCharge"
+	PaymentService_ListPayouts_FullMethodName             = "/payment.v1.PaymentService/ListPayouts"
+	PaymentService_GetPayoutStatement_FullMethodName      = "/payment.v1.PaymentService/GetPayoutStatement"
+	PaymentService_MarkPayoutPaid_FullMethodName          = "/payment.v1.PaymentService/MarkPayoutPaid"
+	PaymentService_GetWallet_FullMethodName               = "/payment.v1.PaymentService/GetWallet"
+	PaymentService_TopUp_FullMethodName                   = "/payment.v1.PaymentService/TopUp"
+	PaymentService_ListWalletTransactions_FullMethodName  = "/payment.v1.PaymentService/ListWalletTransactions"
+	PaymentService_PayWithWallet_FullMethodName           = "/payment.v1.PaymentService/PayWithWallet"
+	PaymentService_GetReceipt_FullMethodName              = "/payment.v1.PaymentService/GetReceipt"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -31,6 +44,21 @@ type PaymentServiceClient interface {
 	CreateCardCharge(ctx context.Context, in *CreateCardChargeRequest, opts ...grpc.CallOption) (*CreateCardChargeResponse, error)
 	CreateSourceCharge(ctx context.Context, in *CreateSourceChargeRequest, opts ...grpc.CallOption) (*CreateSourceChargeResponse, error)
 	GetCharge(ctx context.Context, in *GetChargeRequest, opts ...grpc.CallOption) (*GetChargeResponse, error)
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	GetPaymentsByBooking(ctx context.Context, in *GetPaymentsByBookingRequest, opts ...grpc.CallOption) (*GetPaymentsByBookingResponse, error)
+	RefundCharge(ctx context.Context, in *RefundChargeRequest, opts ...grpc.CallOption) (*RefundChargeResponse, error)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error)
+	// WatchCharge ส่งสถานะปัจจุบันแล้วส่งทุกครั้งที่เปลี่ยน; stream ปิดเมื่อ charge จบ (successful/failed/expired/reversed)
+	WatchCharge(ctx context.Context, in *WatchChargeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChargeStatusEvent], error)
+	ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*ListPayoutsResponse, error)
+	GetPayoutStatement(ctx context.Context, in *GetPayoutStatementRequest, opts ...grpc.CallOption) (*GetPayoutStatementResponse, error)
+	MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, opts ...grpc.CallOption) (*MarkPayoutPaidResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	TopUp(ctx context.Context, in *TopUpRequest, opts ...grpc.CallOption) (*TopUpResponse, error)
+	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error)
+	PayWithWallet(ctx context.Context, in *PayWithWalletRequest, opts ...grpc.CallOption) (*PayWithWalletResponse, error)
+	// GetReceipt ใบเสร็จของ charge ที่จ่ายสำเร็จ (ยังไม่ได้ออก = ออกให้ตอนนี้)
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -71,6 +99,145 @@ func (c *paymentServiceClient) GetCharge(ctx context.Context, in *GetChargeReque
 	return out, nil
 }
 
+func (c *paymentServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentsByBooking(ctx context.Context, in *GetPaymentsByBookingRequest, opts ...grpc.CallOption) (*GetPaymentsByBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentsByBookingResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentsByBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundCharge(ctx context.Context, in *RefundChargeRequest, opts ...grpc.CallOption) (*RefundChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundChargeResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) WatchCharge(ctx context.Context, in *WatchChargeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChargeStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PaymentService_ServiceDesc.Streams[0], PaymentService_WatchCharge_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChargeRequest, ChargeStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentService_WatchChargeClient = grpc.ServerStreamingClient[ChargeStatusEvent]
+
+func (c *paymentServiceClient) ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*ListPayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPayoutStatement(ctx context.Context, in *GetPayoutStatementRequest, opts ...grpc.CallOption) (*GetPayoutStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoutStatementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPayoutStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, opts ...grpc.CallOption) (*MarkPayoutPaidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkPayoutPaidResponse)
+	err := c.cc.Invoke(ctx, PaymentService_MarkPayoutPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) TopUp(ctx context.Context, in *TopUpRequest, opts ...grpc.CallOption) (*TopUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpResponse)
+	err := c.cc.Invoke(ctx, PaymentService_TopUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListWalletTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PayWithWallet(ctx context.Context, in *PayWithWalletRequest, opts ...grpc.CallOption) (*PayWithWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayWithWalletResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PayWithWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -78,6 +245,21 @@ type PaymentServiceServer interface {
 	CreateCardCharge(context.Context, *CreateCardChargeRequest) (*CreateCardChargeResponse, error)
 	CreateSourceCharge(context.Context, *CreateSourceChargeRequest) (*CreateSourceChargeResponse, error)
 	GetCharge(context.Context, *GetChargeRequest) (*GetChargeResponse, error)
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	GetPaymentsByBooking(context.Context, *GetPaymentsByBookingRequest) (*GetPaymentsByBookingResponse, error)
+	RefundCharge(context.Context, *RefundChargeRequest) (*RefundChargeResponse, error)
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error)
+	// WatchCharge ส่งสถานะปัจจุบันแล้วส่งทุกครั้งที่เปลี่ยน; stream ปิดเมื่อ charge จบ (successful/failed/expired/reversed)
+	WatchCharge(*WatchChargeRequest, grpc.ServerStreamingServer[ChargeStatusEvent]) error
+	ListPayouts(context.Context, *ListPayoutsRequest) (*ListPayoutsResponse, error)
+	GetPayoutStatement(context.Context, *GetPayoutStatementRequest) (*GetPayoutStatementResponse, error)
+	MarkPayoutPaid(context.Context, *MarkPayoutPaidRequest) (*MarkPayoutPaidResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	TopUp(context.Context, *TopUpRequest) (*TopUpResponse, error)
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error)
+	PayWithWallet(context.Context, *PayWithWalletRequest) (*PayWithWalletResponse, error)
+	// GetReceipt ใบเสร็จของ charge ที่จ่ายสำเร็จ (ยังไม่ได้ออก = ออกให้ตอนนี้)
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -97,6 +279,45 @@ func (UnimplementedPaymentServiceServer) CreateSourceCharge(context.Context, *Cr
 func (UnimplementedPaymentServiceServer) GetCharge(context.Context, *GetChargeRequest) (*GetChargeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCharge not implemented")
 }
+func (UnimplementedPaymentServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentsByBooking(context.Context, *GetPaymentsByBookingRequest) (*GetPaymentsByBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsByBooking not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundCharge(context.Context, *RefundChargeRequest) (*RefundChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCharge not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) WatchCharge(*WatchChargeRequest, grpc.ServerStreamingServer[ChargeStatusEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCharge not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPayouts(context.Context, *ListPayoutsRequest) (*ListPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayouts not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPayoutStatement(context.Context, *GetPayoutStatementRequest) (*GetPayoutStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutStatement not implemented")
+}
+func (UnimplementedPaymentServiceServer) MarkPayoutPaid(context.Context, *MarkPayoutPaidRequest) (*MarkPayoutPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPayoutPaid not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedPaymentServiceServer) TopUp(context.Context, *TopUpRequest) (*TopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUp not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
+}
+func (UnimplementedPaymentServiceServer) PayWithWallet(context.Context, *PayWithWalletRequest) (*PayWithWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayWithWallet not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -172,6 +393,233 @@ func _PaymentService_GetCharge_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentsByBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentsByBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentsByBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentsByBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentsByBooking(ctx, req.(*GetPaymentsByBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundCharge(ctx, req.(*RefundChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetReconciliationReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_WatchCharge_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChargeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PaymentServiceServer).WatchCharge(m, &grpc.GenericServerStream[WatchChargeRequest, ChargeStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentService_WatchChargeServer = grpc.ServerStreamingServer[ChargeStatusEvent]
+
+func _PaymentService_ListPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPayouts(ctx, req.(*ListPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPayoutStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPayoutStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPayoutStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPayoutStatement(ctx, req.(*GetPayoutStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_MarkPayoutPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPayoutPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).MarkPayoutPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_MarkPayoutPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).MarkPayoutPaid(ctx, req.(*MarkPayoutPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_TopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).TopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_TopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).TopUp(ctx, req.(*TopUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListWalletTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListWalletTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListWalletTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PayWithWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayWithWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PayWithWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PayWithWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PayWithWallet(ctx, req.(*PayWithWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,7 +639,61 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCharge",
 			Handler:    _PaymentService_GetCharge_Handler,
 		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _PaymentService_ListPayments_Handler,
+		},
+		{
+			MethodName: "GetPaymentsByBooking",
+			Handler:    _PaymentService_GetPaymentsByBooking_Handler,
+		},
+		{
+			MethodName: "RefundCharge",
+			Handler:    _PaymentService_RefundCharge_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _PaymentService_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "ListPayouts",
+			Handler:    _PaymentService_ListPayouts_Handler,
+		},
+		{
+			MethodName: "GetPayoutStatement",
+			Handler:    _PaymentService_GetPayoutStatement_Handler,
+		},
+		{
+			MethodName: "MarkPayoutPaid",
+			Handler:    _PaymentService_MarkPayoutPaid_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _PaymentService_GetWallet_Handler,
+		},
+		{
+			MethodName: "TopUp",
+			Handler:    _PaymentService_TopUp_Handler,
+		},
+		{
+			MethodName: "ListWalletTransactions",
+			Handler:    _PaymentService_ListWalletTransactions_Handler,
+		},
+		{
+			MethodName: "PayWithWallet",
+			Handler:    _PaymentService_PayWithWallet_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _PaymentService_GetReceipt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCharge",
+			Handler:       _PaymentService_WatchCharge_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/v1/payment.proto",
 }
@@ -35,6 +35,18 @@ func main() {
 	{
 		v1.POST("/auth/register", a.Register)
 		v1.POST("/auth/login", a.Login)
+		v1.POST("/auth/refresh", a.Refresh)
+		v1.POST("/auth/logout", a.Logout)
+		v1.PUT("/auth/users/:id/role", middlewares.JWTAuth(), middlewares.RequireRole("ADMIN"), a.AssignRole)
+
+		apps := v1.Group("/owner-applications")
+		apps.Use(middlewares.JWTAuth())
+		{
+			apps.POST("", a.ApplyForOwner)
+			apps.GET("", a.ListOwnerApplications)
+			apps.POST("/:id/approve", middlewares.RequireRole("ADMIN"), a.ApproveOwnerApplication)
+			apps.POST("/:id/reject", middlewares.RequireRole("ADMIN"), a.RejectOwnerApplication)
+		}
 
 		uh := handlers.NewUserHandler(c)
 		{
@@ -59,26 +71,64 @@ func main() {
 		)
 
 		bh := handlers.NewBookingHandler(c)
+		ph := handlers.NewPaymentHandler(c)
+		v1.GET("/courts/:id/availability", bh.Availability)
+
 		secured := v1.Group("")
 		secured.Use(middlewares.JWTAuth())
 		{
 			secured.POST("/bookings", bh.Create)
+			secured.POST("/bookings/quote", bh.Quote)
+			secured.POST("/bookings/recurring", bh.CreateRecurring)
+			secured.POST("/bookings/series/:id/cancel", bh.CancelSeries)
 			secured.GET("/bookings", bh.List)
 			secured.GET("/bookings/:id", bh.Get)
+			secured.GET("/bookings/:id/history", bh.History)
+			secured.GET("/bookings/:id/payments", ph.ListByBooking)
 
 			owner := secured.Group("")
 			owner.Use(middlewares.RequireRole("OWNER", "ADMIN"))
 			owner.POST("/bookings/:id/confirm", bh.Confirm)
+			owner.POST("/bookings/:id/status", bh.UpdateStatus)
 
 			secured.POST("/bookings/:id/cancel", bh.Cancel)
+			secured.POST("/bookings/:id/reschedule", bh.Reschedule)
+			secured.POST("/bookings/:id/split", bh.Split)
+			secured.POST("/bookings/:id/shares/cover", bh.CoverShares)
 		}
 		pay := v1.Group("/payments")
 		pay.Use(middlewares.JWTAuth())
 		{
-			ph := handlers.NewPaymentHandler(c)
+			pay.GET("", ph.List)
 			pay.POST("/charges/card", ph.CreateCardCharge)
 			pay.POST("/charges/source", ph.CreateSourceCharge)
 			pay.GET("/charges/:id", ph.GetCharge)
+			pay.GET("/charges/:id/events", ph.WatchCharge)
+			pay.GET("/charges/:id/receipt", ph.Receipt)
+			pay.POST("/charges/:id/refund", middlewares.RequireRole("ADMIN"), ph.RefundCharge)
+			pay.GET("/reconciliation", middlewares.RequireRole("ADMIN"), ph.ReconciliationReport)
+		}
+		wallet := v1.Group("/wallet")
+		wallet.Use(middlewares.JWTAuth())
+		{
+			wallet.GET("", ph.GetWallet)
+			wallet.POST("/topup", ph.TopUp)
+			wallet.GET("/transactions", ph.ListWalletTransactions)
+			wallet.POST("/pay", ph.PayWithWallet)
+		}
+		promos := v1.Group("/promotions")
+		promos.Use(middlewares.JWTAuth(), middlewares.RequireRole("ADMIN"))
+		{
+			promos.POST("", bh.CreatePromotion)
+			promos.GET("", bh.ListPromotions)
+			promos.POST("/:code/active", bh.SetPromotionActive)
+		}
+		payouts := v1.Group("/payouts")
+		payouts.Use(middlewares.JWTAuth(), middlewares.RequireRole("OWNER", "ADMIN"))
+		{
+			payouts.GET("", ph.ListPayouts)
+			payouts.GET("/:id", ph.GetPayoutStatement)
+			payouts.POST("/:id/paid", middlewares.RequireRole("ADMIN"), ph.MarkPayoutPaid)
 		}
 
 	}
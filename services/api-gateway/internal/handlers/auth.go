@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	authv1 "github.com/you/badminton-booking/proto/auth/v1"
 	userv1 "github.com/you/badminton-booking/proto/user/v1"
@@ -19,12 +20,12 @@ func NewAuthHandler(c *clients.Clients) *AuthHandler {
 }
 
 func (h *AuthHandler) Register(c *gin.Context) {
-	var in struct{ Email, Password, Name, Role string }
+	var in struct{ Email, Password, Name string } // สมัครได้ USER เสมอ (OWNER ยื่นคำขอที่ /v1/owner-applications)
 	if err := c.ShouldBindJSON(&in); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	res, err := h.c.Auth.Register(c, &authv1.RegisterRequest{Email: in.Email, Password: in.Password, Name: in.Name, Role: in.Role})
+	res, err := h.c.Auth.Register(c, &authv1.RegisterRequest{Email: in.Email, Password: in.Password, Name: in.Name})
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -50,3 +51,115 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, res)
 }
+
+// POST /v1/auth/refresh {"refresh_token": "..."} — ได้ access/refresh คู่ใหม่; refresh token เดิมใช้ไม่ได้อีก
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var in struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Auth.RefreshToken(c, &authv1.RefreshTokenRequest{RefreshToken: in.RefreshToken})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/auth/logout {"refresh_token": "..."} — เพิกถอน refresh token ของ session นี้
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var in struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Auth.Logout(c, &authv1.LogoutRequest{RefreshToken: in.RefreshToken})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// PUT /v1/auth/users/:id/role {"role": "OWNER"} (ADMIN) — :id = user id ใน auth (sub ของ JWT)
+// token เดิมของ user นั้นได้ role ใหม่ตอน refresh ครั้งถัดไป
+func (h *AuthHandler) AssignRole(c *gin.Context) {
+	var in struct {
+		Role string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Auth.AssignRole(injectUserMD(c), &authv1.AssignRoleRequest{UserId: c.Param("id"), Role: in.Role})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/owner-applications {"business_name": "...", "note": "..."} — ขอเป็นเจ้าของสนาม (รอ ADMIN อนุมัติ)
+func (h *AuthHandler) ApplyForOwner(c *gin.Context) {
+	var in struct {
+		BusinessName string `json:"business_name" binding:"required"`
+		Note         string `json:"note"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Auth.ApplyForOwner(injectUserMD(c), &authv1.ApplyForOwnerRequest{BusinessName: in.BusinessName, Note: in.Note})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GET /v1/owner-applications?status=PENDING&user_id=...&page=1&page_size=20
+// ไม่ใช่ ADMIN เห็นเฉพาะคำขอของตัวเอง (auth-service บังคับ)
+func (h *AuthHandler) ListOwnerApplications(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	res, err := h.c.Auth.ListOwnerApplications(injectUserMD(c), &authv1.ListOwnerApplicationsRequest{
+		Page:     int32(page - 1),
+		PageSize: int32(size),
+		UserId:   c.Query("user_id"),
+		Status:   c.Query("status"),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/owner-applications/:id/approve {"note": "..."} (ADMIN) — ผู้สมัครเป็น OWNER
+func (h *AuthHandler) ApproveOwnerApplication(c *gin.Context) { h.reviewOwnerApplication(c, true) }
+
+// POST /v1/owner-applications/:id/reject {"note": "..."} (ADMIN)
+func (h *AuthHandler) RejectOwnerApplication(c *gin.Context) { h.reviewOwnerApplication(c, false) }
+
+func (h *AuthHandler) reviewOwnerApplication(c *gin.Context, approve bool) {
+	var in struct {
+		Note string `json:"note"`
+	}
+	_ = c.ShouldBindJSON(&in) // body ไม่บังคับ
+	res, err := h.c.Auth.ReviewOwnerApplication(injectUserMD(c), &authv1.ReviewOwnerApplicationRequest{
+		Id: c.Param("id"), Approve: approve, Note: in.Note,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -20,9 +20,10 @@ func NewBookingHandler(c *clients.Clients) *BookingHandler {
 // POST /v1/bookings
 func (h *BookingHandler) Create(c *gin.Context) {
 	var in struct {
-		CourtID  string `json:"court_id" binding:"required"`
-		StartISO string `json:"start_iso" binding:"required"` // RFC3339
-		EndISO   string `json:"end_iso"   binding:"required"` // RFC3339
+		CourtID   string `json:"court_id" binding:"required"`
+		StartISO  string `json:"start_iso" binding:"required"` // RFC3339
+		EndISO    string `json:"end_iso"   binding:"required"` // RFC3339
+		PromoCode string `json:"promo_code"`
 	}
 	if err := c.ShouldBindJSON(&in); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -30,36 +31,221 @@ func (h *BookingHandler) Create(c *gin.Context) {
 	}
 	sub, _ := c.Get("sub") // set by JWTAuth middleware
 	userID, _ := sub.(string)
-	res, err := h.c.Book.CreateBooking(c, &bookingv1.CreateBookingRequest{
+	res, err := h.c.Book.CreateBooking(injectUserMD(c), &bookingv1.CreateBookingRequest{
 		UserId:   userID,
 		CourtId:  in.CourtID,
 		StartIso: in.StartISO,
 		EndIso:   in.EndISO,
+
+		PromoCode: in.PromoCode,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// POST /v1/bookings/recurring — จองซ้ำรายสัปดาห์ (count หรือ until_iso)
+func (h *BookingHandler) CreateRecurring(c *gin.Context) {
+	var in struct {
+		CourtID       string `json:"court_id" binding:"required"`
+		StartISO      string `json:"start_iso" binding:"required"` // RFC3339 ของครั้งแรก
+		EndISO        string `json:"end_iso"   binding:"required"` // RFC3339 ของครั้งแรก
+		IntervalWeeks int32  `json:"interval_weeks"`
+		UntilISO      string `json:"until_iso"`
+		Count         int32  `json:"count"`
+		SkipConflicts bool   `json:"skip_conflicts"` // false = all-or-nothing
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sub, _ := c.Get("sub")
+	userID, _ := sub.(string)
+	mode := bookingv1.ConflictMode_ALL_OR_NOTHING
+	if in.SkipConflicts {
+		mode = bookingv1.ConflictMode_SKIP_CONFLICTS
+	}
+	res, err := h.c.Book.CreateRecurringBooking(injectUserMD(c), &bookingv1.CreateRecurringBookingRequest{
+		UserId:        userID,
+		CourtId:       in.CourtID,
+		StartIso:      in.StartISO,
+		EndIso:        in.EndISO,
+		IntervalWeeks: in.IntervalWeeks,
+		UntilIso:      in.UntilISO,
+		Count:         in.Count,
+		ConflictMode:  mode,
 	})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
+		return
+	}
+	if res.Series == nil {
+		c.JSON(http.StatusConflict, res)
 		return
 	}
 	c.JSON(http.StatusCreated, res)
 }
 
+// POST /v1/bookings/series/:id/cancel — ยกเลิกทุกครั้งที่ยังไม่เริ่มของ series
+func (h *BookingHandler) CancelSeries(c *gin.Context) {
+	var in struct {
+		Reason string `json:"reason"`
+	}
+	_ = c.ShouldBindJSON(&in) // body ไม่บังคับ
+	res, err := h.c.Book.CancelBookingSeries(injectUserMD(c), &bookingv1.CancelBookingSeriesRequest{SeriesId: c.Param("id"), Reason: in.Reason})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/bookings/quote — ส่ง booking_id หรือ court_id+start_iso+end_iso (+promo_code)
+func (h *BookingHandler) Quote(c *gin.Context) {
+	var in struct {
+		BookingID string `json:"booking_id"`
+		CourtID   string `json:"court_id"`
+		StartISO  string `json:"start_iso"` // RFC3339
+		EndISO    string `json:"end_iso"`   // RFC3339
+		PromoCode string `json:"promo_code"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Book.QuoteBooking(injectUserMD(c), &bookingv1.QuoteBookingRequest{
+		BookingId: in.BookingID,
+		CourtId:   in.CourtID,
+		StartIso:  in.StartISO,
+		EndIso:    in.EndISO,
+		PromoCode: in.PromoCode,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
 // POST /v1/bookings/:id/confirm (OWNER/ADMIN)
 func (h *BookingHandler) Confirm(c *gin.Context) {
 	id := c.Param("id")
-	res, err := h.c.Book.ConfirmBooking(c, &bookingv1.ConfirmBookingRequest{Id: id})
+	res, err := h.c.Book.ConfirmBooking(injectUserMD(c), &bookingv1.ConfirmBookingRequest{Id: id})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, res)
 }
 
-// POST /v1/bookings/:id/cancel (owner of booking or ADMIN — MVP allow any authenticated)
+// POST /v1/bookings/:id/cancel {"reason": "..."} (booking-service ตรวจสิทธิ์: เจ้าของ booking, เจ้าของสนาม หรือ ADMIN)
 func (h *BookingHandler) Cancel(c *gin.Context) {
 	id := c.Param("id")
-	res, err := h.c.Book.CancelBooking(c, &bookingv1.CancelBookingRequest{Id: id})
+	var in struct {
+		Reason string `json:"reason"`
+	}
+	_ = c.ShouldBindJSON(&in) // body ไม่บังคับ
+	res, err := h.c.Book.CancelBooking(injectUserMD(c), &bookingv1.CancelBookingRequest{Id: id, Reason: in.Reason})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/bookings/:id/status {"status": "COMPLETED"|"NO_SHOW", "reason": "..."} (OWNER/ADMIN)
+func (h *BookingHandler) UpdateStatus(c *gin.Context) {
+	var in struct {
+		Status string `json:"status" binding:"required"`
+		Reason string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	st, ok := bookingv1.BookingStatus_value[in.Status]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unknown status"})
+		return
+	}
+	res, err := h.c.Book.UpdateBookingStatus(injectUserMD(c), &bookingv1.UpdateBookingStatusRequest{
+		Id:     c.Param("id"),
+		Status: bookingv1.BookingStatus(st),
+		Reason: in.Reason,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GET /v1/bookings/:id/history — ประวัติการเปลี่ยนสถานะ
+func (h *BookingHandler) History(c *gin.Context) {
+	res, err := h.c.Book.GetBookingHistory(injectUserMD(c), &bookingv1.GetBookingHistoryRequest{Id: c.Param("id")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/bookings/:id/reschedule
+func (h *BookingHandler) Reschedule(c *gin.Context) {
+	var in struct {
+		StartISO string `json:"start_iso" binding:"required"` // RFC3339
+		EndISO   string `json:"end_iso"   binding:"required"` // RFC3339
+		CourtID  string `json:"court_id"`                     // optional; ว่าง = สนามเดิม
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Book.RescheduleBooking(injectUserMD(c), &bookingv1.RescheduleBookingRequest{
+		Id:          c.Param("id"),
+		NewStartIso: in.StartISO,
+		NewEndIso:   in.EndISO,
+		NewCourtId:  in.CourtID,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/bookings/:id/split {"participants": [{"user_id": "..."}, {"email": "..."}]}
+// แบ่งค่าสนามเท่า ๆ กันกับผู้ถูกเชิญ แต่ละคนจ่ายส่วนของตัวเองด้วย share_id; ดูความคืบหน้าที่ GET /v1/bookings/:id
+func (h *BookingHandler) Split(c *gin.Context) {
+	var in struct {
+		Participants []struct {
+			UserID string `json:"user_id"`
+			Email  string `json:"email"`
+		} `json:"participants" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req := &bookingv1.SplitBookingRequest{Id: c.Param("id")}
+	for _, p := range in.Participants {
+		req.Participants = append(req.Participants, &bookingv1.SplitParticipant{UserId: p.UserID, Email: p.Email})
+	}
+	res, err := h.c.Book.SplitBooking(injectUserMD(c), req)
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/bookings/:id/shares/cover — ผู้จัดรับจ่ายส่วนที่ยังไม่มีใครจ่ายทั้งหมด (ได้ share ใหม่หนึ่งส่วน)
+func (h *BookingHandler) CoverShares(c *gin.Context) {
+	res, err := h.c.Book.CoverBookingShares(injectUserMD(c), &bookingv1.CoverBookingSharesRequest{Id: c.Param("id")})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, res)
@@ -68,15 +254,16 @@ func (h *BookingHandler) Cancel(c *gin.Context) {
 // GET /v1/bookings/:id
 func (h *BookingHandler) Get(c *gin.Context) {
 	id := c.Param("id")
-	res, err := h.c.Book.GetBooking(c, &bookingv1.GetBookingRequest{Id: id})
+	res, err := h.c.Book.GetBooking(injectUserMD(c), &bookingv1.GetBookingRequest{Id: id})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, res)
 }
 
 // GET /v1/bookings?page=1&page_size=20&user_id=...&court_id=...&day=RFC3339
+// booking-service จำกัดผลตาม role ของผู้เรียก (USER เห็นเฉพาะของตัวเอง)
 func (h *BookingHandler) List(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
@@ -91,9 +278,82 @@ func (h *BookingHandler) List(c *gin.Context) {
 		CourtId:  c.Query("court_id"),
 		DayIso:   c.Query("day"),
 	}
-	res, err := h.c.Book.ListBooking(c, req)
+	res, err := h.c.Book.ListBooking(injectUserMD(c), req)
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GET /v1/courts/:id/availability?date=YYYY-MM-DD
+func (h *BookingHandler) Availability(c *gin.Context) {
+	date := c.Query("date")
+	if date == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "missing date (YYYY-MM-DD)"})
+		return
+	}
+	res, err := h.c.Book.GetAvailability(c, &bookingv1.GetAvailabilityRequest{
+		CourtId: c.Param("id"),
+		Date:    date,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ---------- Promotions (ADMIN) ----------
+
+// POST /v1/promotions — body ตาม bookingv1.Promotion เช่น
+// {"code":"MORNING20","kind":"PERCENT","value":20,"weekdays":[1,2,3,4,5],"time_from":"06:00","time_to":"12:00"}
+func (h *BookingHandler) CreatePromotion(c *gin.Context) {
+	var in bookingv1.Promotion
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Book.CreatePromotion(injectUserMD(c), &bookingv1.CreatePromotionRequest{Promotion: &in})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GET /v1/promotions?page=1&page_size=20&active_only=true
+func (h *BookingHandler) ListPromotions(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	activeOnly, _ := strconv.ParseBool(c.Query("active_only"))
+	res, err := h.c.Book.ListPromotions(injectUserMD(c), &bookingv1.ListPromotionsRequest{
+		Page:       int32(page - 1),
+		PageSize:   int32(size),
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/promotions/:code/active {"active": false} — ปิด/เปิดโค้ด
+func (h *BookingHandler) SetPromotionActive(c *gin.Context) {
+	var in struct {
+		Active *bool `json:"active" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	res, err := h.c.Book.SetPromotionActive(injectUserMD(c), &bookingv1.SetPromotionActiveRequest{Code: c.Param("code"), Active: *in.Active})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, res)
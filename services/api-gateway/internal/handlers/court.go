@@ -35,7 +35,10 @@ func (h *CourtHandler) Create(c *gin.Context) {
 		return
 	}
 
+	sub, _ := c.Get("sub") // set by JWTAuth middleware
+	ownerID, _ := sub.(string)
 	res, err := h.c.Court.CreateCourt(c, &courtv1.CreateCourtRequest{
+		OwnerId:      ownerID,
 		Venue:        in.Venue,
 		CourtNo:      in.CourtNo,
 		PricePerHour: in.PricePerHour,
@@ -65,19 +68,24 @@ func (h *CourtHandler) List(c *gin.Context) {
 
 	res, err := h.c.Court.ListCourts(ctx, req)
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			c.JSON(grpcCodeToHTTP(st.Code()), gin.H{
-				"error":   st.Message(),
-				"details": st.Details(),
-			})
-		} else {
-			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-		}
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, res)
 }
 
+// writeGRPCError ตอบ error จาก gRPC เป็น HTTP status ที่ตรงกับ code
+func writeGRPCError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		c.JSON(grpcCodeToHTTP(st.Code()), gin.H{
+			"error":   st.Message(),
+			"details": st.Details(),
+		})
+		return
+	}
+	c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+}
+
 func grpcCodeToHTTP(code codes.Code) int {
 	switch code {
 	case codes.InvalidArgument:
@@ -88,7 +96,7 @@ func grpcCodeToHTTP(code codes.Code) int {
 		return http.StatusUnauthorized
 	case codes.PermissionDenied:
 		return http.StatusForbidden
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.Aborted:
 		return http.StatusConflict
 	case codes.Unimplemented:
 		return http.StatusNotImplemented
@@ -1,10 +1,16 @@
 package handlers
 
 import (
+	"context"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
 	paymentv1 "github.com/you/badminton-booking/proto/payment/v1"
 	"github.com/you/badminton-booking/services/api-gateway/internal/clients"
 )
@@ -18,9 +24,10 @@ func NewPaymentHandler(c *clients.Clients) *PaymentHandler { return &PaymentHand
 // ---------- Card ----------
 type createCardChargeBody struct {
 	BookingID string `json:"booking_id" binding:"required"`
-	Amount    int64  `json:"amount" binding:"required"`
+	Amount    int64  `json:"amount" binding:"required"`   // ต้องตรงกับราคาของ booking (ดู POST /v1/bookings/quote)
 	Currency  string `json:"currency" binding:"required"` // "THB"
 	CardToken string `json:"card_token" binding:"required"`
+	ShareID   string `json:"share_id"` // booking หารจ่าย: ส่วนที่จ่าย (amount = ยอดของส่วนนั้น)
 }
 
 func (h *PaymentHandler) CreateCardCharge(c *gin.Context) {
@@ -30,14 +37,17 @@ func (h *PaymentHandler) CreateCardCharge(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.c.Pay.CreateCardCharge(c, &paymentv1.CreateCardChargeRequest{
+	resp, err := h.c.Pay.CreateCardCharge(injectUserMD(c), &paymentv1.CreateCardChargeRequest{
 		BookingId: body.BookingID,
 		Amount:    body.Amount,
 		Currency:  body.Currency,
 		CardToken: body.CardToken,
+		ShareId:   body.ShareID,
+
+		IdempotencyKey: c.GetHeader("Idempotency-Key"), // กด pay ซ้ำด้วย key เดิมได้ charge เดิม
 	})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -51,6 +61,7 @@ type createSourceChargeBody struct {
 	BookingID string `json:"booking_id" binding:"required"`
 	Amount    int64  `json:"amount" binding:"required"`
 	Currency  string `json:"currency" binding:"required"` // "THB"
+	ShareID   string `json:"share_id"`                    // booking หารจ่าย: ส่วนที่จ่าย
 
 	// ทางเลือกที่ 1: ส่งมาเลย
 	SourceID string `json:"source_id"`
@@ -74,15 +85,18 @@ func (h *PaymentHandler) CreateSourceCharge(c *gin.Context) {
 		SourceId:   body.SourceID,   // อาจว่างได้
 		ReturnUri:  body.ReturnURI,  // server อาจใช้เมื่อต้องสร้าง source แบบ redirect
 		SourceType: body.SourceType, // <-- ต้องมี field นี้ใน proto (ถ้าใช้วิธีที่ 2)
+		ShareId:    body.ShareID,
+
+		IdempotencyKey: c.GetHeader("Idempotency-Key"), // กด pay ซ้ำด้วย key เดิมได้ charge เดิม
 	}
 
 	// หมายเหตุ:
 	// - ถ้า proto ของคุณ 'ยังไม่มี' SourceType: ให้ลบบรรทัดตั้งค่า SourceType ออก และบังคับว่าต้องมี SourceId
 	// - ถ้ามีแล้ว: ฝั่ง payment-service จะตรวจเองว่า ถ้า SourceId ว่างแต่มี SourceType ก็ไปเรียก CreateSourceOrUseExisting
 
-	resp, err := h.c.Pay.CreateSourceCharge(c, req)
+	resp, err := h.c.Pay.CreateSourceCharge(injectUserMD(c), req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -101,3 +115,309 @@ func (h *PaymentHandler) GetCharge(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// GET /v1/payments/charges/:id/events — SSE สถานะของ charge (event "status") จนกว่าจะจบ; ผู้จ่ายหรือ ADMIN เท่านั้น
+// ให้ kiosk ขึ้น "ชำระแล้ว" ได้ทันทีที่ webhook มา ไม่ต้อง poll GetCharge
+func (h *PaymentHandler) WatchCharge(c *gin.Context) {
+	ctx, cancel := context.WithCancel(injectUserMD(c)) // payment-service ตรวจว่าเป็นผู้จ่ายหรือ ADMIN
+	defer cancel()
+
+	stream, err := h.c.Pay.WatchCharge(ctx, &paymentv1.WatchChargeRequest{ChargeId: c.Param("id")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	// รอ event แรก (สถานะปัจจุบัน) ก่อน เพื่อให้ NotFound ฯลฯ ตอบเป็น HTTP status ปกติได้
+	first, err := stream.Recv()
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no") // กัน reverse proxy buffer
+	c.SSEvent("status", first)
+	c.Writer.Flush()
+
+	evs := make(chan *paymentv1.ChargeStatusEvent)
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case evs <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(15 * time.Second) // กัน proxy ตัด connection ที่เงียบนาน
+	defer ping.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case ev := <-evs:
+			c.SSEvent("status", ev)
+			return true
+		case err := <-errc:
+			if err != io.EOF { // EOF = charge จบแล้ว
+				c.SSEvent("error", gin.H{"error": status.Convert(err).Message()})
+			}
+			return false
+		case <-ping.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
+
+// GET /v1/payments/charges/:id/receipt — PDF ใบเสร็จ; เจ้าของ payment หรือ ADMIN เท่านั้น
+func (h *PaymentHandler) Receipt(c *gin.Context) {
+	// payment-service ตรวจสิทธิ์ก่อนออกใบ (คนอื่นได้ NotFound ไม่บอกว่ามีอยู่)
+	resp, err := h.c.Pay.GetReceipt(injectUserMD(c), &paymentv1.GetReceiptRequest{ChargeId: c.Param("id")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="`+resp.GetReceipt().GetNumber()+`.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", resp.GetPdf())
+}
+
+// POST /v1/payments/charges/:id/refund (ADMIN) — amount 0/ไม่ส่ง = คืนเต็มยอดที่เหลือ
+type refundChargeBody struct {
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+func (h *PaymentHandler) RefundCharge(c *gin.Context) {
+	var body refundChargeBody
+	_ = c.ShouldBindJSON(&body) // body ไม่บังคับ
+	resp, err := h.c.Pay.RefundCharge(c, &paymentv1.RefundChargeRequest{
+		ChargeId: c.Param("id"),
+		Amount:   body.Amount,
+		Reason:   body.Reason,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /v1/payments?page=1&page_size=20&user_id=...&booking_id=...&status=...
+// ADMIN ดูได้ทุกคน; role อื่นเห็นเฉพาะ payment ของตัวเอง (user_id ถูกแทนด้วยผู้เรียก)
+func (h *PaymentHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	userID := c.Query("user_id")
+	if role, _ := c.Get("role"); role != "ADMIN" {
+		sub, _ := c.Get("sub")
+		userID, _ = sub.(string)
+	}
+	resp, err := h.c.Pay.ListPayments(c, &paymentv1.ListPaymentsRequest{
+		Page:      int32(page - 1),
+		PageSize:  int32(size),
+		UserId:    userID,
+		BookingId: c.Query("booking_id"),
+		Status:    c.Query("status"),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /v1/bookings/:id/payments — ต้องมีสิทธิ์ดู booking นั้นก่อน (booking-service ตรวจให้)
+func (h *PaymentHandler) ListByBooking(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := h.c.Book.GetBooking(injectUserMD(c), &bookingv1.GetBookingRequest{Id: id}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	resp, err := h.c.Pay.GetPaymentsByBooking(c, &paymentv1.GetPaymentsByBookingRequest{BookingId: id})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /v1/payments/reconciliation?run_id=... (ADMIN) — ไม่ส่ง run_id = รอบล่าสุด
+func (h *PaymentHandler) ReconciliationReport(c *gin.Context) {
+	resp, err := h.c.Pay.GetReconciliationReport(c, &paymentv1.GetReconciliationReportRequest{RunId: c.Query("run_id")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ---------- Payouts (ใบสรุปยอดของเจ้าของสนาม) ----------
+
+// GET /v1/payouts?page=1&page_size=20&owner_id=...&status=PENDING|PAID (OWNER/ADMIN)
+// OWNER เห็นเฉพาะของตัวเอง (owner_id ถูกแทนด้วยผู้เรียก)
+func (h *PaymentHandler) ListPayouts(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	ownerID := c.Query("owner_id")
+	if role, _ := c.Get("role"); role != "ADMIN" {
+		sub, _ := c.Get("sub")
+		ownerID, _ = sub.(string)
+	}
+	resp, err := h.c.Pay.ListPayouts(c, &paymentv1.ListPayoutsRequest{
+		Page:     int32(page - 1),
+		PageSize: int32(size),
+		OwnerId:  ownerID,
+		Status:   c.Query("status"),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /v1/payouts/:id (OWNER/ADMIN) — ใบสรุปพร้อมรายการ charge/refund
+func (h *PaymentHandler) GetPayoutStatement(c *gin.Context) {
+	resp, err := h.c.Pay.GetPayoutStatement(c, &paymentv1.GetPayoutStatementRequest{Id: c.Param("id")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	if role, _ := c.Get("role"); role != "ADMIN" {
+		if sub, _ := c.Get("sub"); resp.GetPayout().GetOwnerId() != sub {
+			c.JSON(http.StatusNotFound, gin.H{"error": "payout not found"}) // ไม่บอกว่ามีอยู่
+			return
+		}
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /v1/payouts/:id/paid {"reference": "..."} (ADMIN) — บันทึกว่าโอนให้เจ้าของสนามแล้ว
+type markPayoutPaidBody struct {
+	Reference string `json:"reference"`
+}
+
+func (h *PaymentHandler) MarkPayoutPaid(c *gin.Context) {
+	var body markPayoutPaidBody
+	_ = c.ShouldBindJSON(&body) // body ไม่บังคับ
+	resp, err := h.c.Pay.MarkPayoutPaid(c, &paymentv1.MarkPayoutPaidRequest{
+		Id:        c.Param("id"),
+		Reference: body.Reference,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ---------- Wallet (ยอดเงินเติมล่วงหน้า) ----------
+
+// walletUser ผู้เรียกเอง; ADMIN ดูของคนอื่นได้ด้วย ?user_id=
+func walletUser(c *gin.Context) string {
+	sub, _ := c.Get("sub")
+	userID, _ := sub.(string)
+	if role, _ := c.Get("role"); role == "ADMIN" && c.Query("user_id") != "" {
+		userID = c.Query("user_id")
+	}
+	return userID
+}
+
+// GET /v1/wallet
+func (h *PaymentHandler) GetWallet(c *gin.Context) {
+	resp, err := h.c.Pay.GetWallet(c, &paymentv1.GetWalletRequest{UserId: walletUser(c)})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /v1/wallet/topup — card_token หรือ source_type ("promptpay" ฯลฯ) อย่างใดอย่างหนึ่ง
+type topUpBody struct {
+	Amount     int64  `json:"amount" binding:"required"`
+	Currency   string `json:"currency" binding:"required"` // "THB"
+	CardToken  string `json:"card_token"`
+	SourceType string `json:"source_type"`
+	ReturnURI  string `json:"return_uri"`
+}
+
+func (h *PaymentHandler) TopUp(c *gin.Context) {
+	var body topUpBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sub, _ := c.Get("sub")
+	userID, _ := sub.(string)
+	resp, err := h.c.Pay.TopUp(c, &paymentv1.TopUpRequest{
+		UserId:     userID, // เติมให้ตัวเองเท่านั้น
+		Amount:     body.Amount,
+		Currency:   body.Currency,
+		CardToken:  body.CardToken,
+		SourceType: body.SourceType,
+		ReturnUri:  body.ReturnURI,
+
+		IdempotencyKey: c.GetHeader("Idempotency-Key"),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /v1/wallet/transactions?page=1&page_size=20
+func (h *PaymentHandler) ListWalletTransactions(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	resp, err := h.c.Pay.ListWalletTransactions(c, &paymentv1.ListWalletTransactionsRequest{
+		UserId:   walletUser(c),
+		Page:     int32(page - 1),
+		PageSize: int32(size),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /v1/wallet/pay {"booking_id": "..."} — จ่าย booking ของตัวเองด้วย wallet
+type payWithWalletBody struct {
+	BookingID string `json:"booking_id" binding:"required"`
+}
+
+func (h *PaymentHandler) PayWithWallet(c *gin.Context) {
+	var body payWithWalletBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sub, _ := c.Get("sub")
+	userID, _ := sub.(string)
+	resp, err := h.c.Pay.PayWithWallet(c, &paymentv1.PayWithWalletRequest{UserId: userID, BookingId: body.BookingID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
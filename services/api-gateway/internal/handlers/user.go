@@ -20,7 +20,8 @@ func NewUserHandler(c *clients.Clients) *UserHandler {
 	return &UserHandler{c: c}
 }
 
-func injectUserMD(c *gin.Context) (ctxWithMD gin.Context, mdCtxForGRPC context.Context) {
+// injectUserMD แนบตัวตนของผู้เรียก (จาก JWTAuth) เป็น gRPC metadata ให้ service ปลายทางตรวจสิทธิ์เอง
+func injectUserMD(c *gin.Context) context.Context {
 	md := metadata.New(nil)
 	if v, ok := c.Get("sub"); ok {
 		md.Append("x-user-id", v.(string))
@@ -31,11 +32,11 @@ func injectUserMD(c *gin.Context) (ctxWithMD gin.Context, mdCtxForGRPC context.C
 	if v, ok := c.Get("role"); ok && v != "" {
 		md.Append("x-user-role", v.(string))
 	}
-	return *c, metadata.NewOutgoingContext(c.Request.Context(), md)
+	return metadata.NewOutgoingContext(c.Request.Context(), md)
 }
 
 func (h *UserHandler) GetMe(c *gin.Context) {
-	_, ctx := injectUserMD(c)
+	ctx := injectUserMD(c)
 	res, err := h.c.User.GetMe(ctx, &userv1.GetMeRequest{})
 	if err != nil {
 		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
@@ -54,7 +55,7 @@ func (h *UserHandler) UpdateMe(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	_, ctx := injectUserMD(c)
+	ctx := injectUserMD(c)
 	res, err := h.c.User.UpdateUser(ctx, &userv1.UpdateUserRequest{
 		Name: in.Name, Phone: in.Phone, AvatarUrl: in.AvatarURL,
 	})
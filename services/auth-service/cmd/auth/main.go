@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"time"
 
 	"github.com/you/badminton-booking/pkg/config"
 	"github.com/you/badminton-booking/pkg/db"
+	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/pkg/outbox"
 	authv1 "github.com/you/badminton-booking/proto/auth/v1"
 	"github.com/you/badminton-booking/services/auth-service/internal/repository"
 	"github.com/you/badminton-booking/services/auth-service/internal/service"
@@ -24,7 +28,18 @@ func main() {
 	if err := repo.Migrate(); err != nil {
 		log.Fatal(err)
 	}
-	svc := service.NewAuthSvc(repo)
+	// Outbox relay (user.* events -> RabbitMQ)
+	if cfg.RabbitURL == "" {
+		log.Fatal("RABBIT_URL is required")
+	}
+	userPub, err := mq.NewPublisher(cfg.RabbitURL, cfg.UserExchange)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer userPub.Close()
+	go outbox.NewRelay(gdb, userPub, cfg.AuthOutboxInterval).Run(context.Background())
+
+	svc := service.NewAuthSvc(repo, time.Duration(cfg.JWTExpireMin)*time.Minute, time.Duration(cfg.RefreshExpireHr)*time.Hour)
 
 	grpcServer := grpc.NewServer()
 	authv1.RegisterAuthServiceServer(grpcServer, tgrpc.NewServer(svc))
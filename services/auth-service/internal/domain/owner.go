@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// ParseRole role ที่ระบบรู้จัก (ตัวพิมพ์ใหญ่)
+func ParseRole(s string) (Role, bool) {
+	switch r := Role(s); r {
+	case RoleUser, RoleOwner, RoleAdmin:
+		return r, true
+	}
+	return "", false
+}
+
+// สถานะของ OwnerApplication
+const (
+	ApplicationPending  = "PENDING"
+	ApplicationApproved = "APPROVED"
+	ApplicationRejected = "REJECTED"
+)
+
+// OwnerApplication คำขอเป็นเจ้าของสนาม; ADMIN อนุมัติแล้ว role เป็น OWNER ทันที
+// (token เดิมได้ role ใหม่ตอน refresh ครั้งถัดไป)
+type OwnerApplication struct {
+	ID           string `gorm:"primaryKey"`
+	UserID       string `gorm:"index"`
+	BusinessName string
+	Note         string // ผู้สมัครเขียนมา เช่น ที่ตั้งสนาม/ช่องทางติดต่อ
+	Status       string `gorm:"index"`
+	ReviewedBy   string
+	ReviewNote   string
+	ReviewedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type Role string
 
 const (
@@ -15,3 +17,16 @@ type User struct {
 	Name         string
 	Role         Role
 }
+
+// RefreshToken refresh token แบบ opaque เก็บเฉพาะ hash; ใช้ได้ครั้งเดียว (หมุนเป็นตัวใหม่ทุกครั้ง)
+// ทุกตัวที่หมุนต่อกันมาจาก login เดียวอยู่ใน family เดียว: ตัวที่ใช้แล้วถูกส่งมาอีก = รั่ว เพิกถอนทั้ง family
+type RefreshToken struct {
+	ID        string `gorm:"primaryKey"`
+	FamilyID  string `gorm:"index"`
+	UserID    string `gorm:"index"`
+	TokenHash string `gorm:"uniqueIndex"` // sha256 (hex) ของ token
+	ExpiresAt time.Time
+	UsedAt    *time.Time // หมุนไปแล้ว
+	RevokedAt *time.Time // logout / ตรวจพบการใช้ซ้ำ
+	CreatedAt time.Time
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/auth-service/internal/domain"
+)
+
+var (
+	ErrApplicationPending = errors.New("an owner application is already pending")
+	ErrApplicationClosed  = errors.New("owner application was already reviewed")
+	ErrNotApplicable      = errors.New("only USER accounts can apply to become an owner")
+)
+
+// Emit สร้าง event ของการเปลี่ยน role (เขียนลง outbox ใน txn เดียวกัน)
+type Emit func(u *domain.User, old domain.Role) []outbox.Event
+
+// setRole เปลี่ยน role ของ user ที่ล็อกไว้แล้ว; role เดิม = ไม่ทำอะไร (ไม่มี event)
+func setRole(tx *gorm.DB, u *domain.User, role domain.Role, emit Emit) error {
+	if u.Role == role {
+		return nil
+	}
+	old := u.Role
+	if err := tx.Model(u).Update("role", role).Error; err != nil {
+		return err
+	}
+	u.Role = role
+	return outbox.Enqueue(tx, emit(u, old)...)
+}
+
+// SetRole กำหนด role ของ userID
+func (r *UserRepo) SetRole(ctx context.Context, userID string, role domain.Role, emit Emit) (*domain.User, error) {
+	var u domain.User
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&u, "id = ?", userID).Error; err != nil {
+			return err
+		}
+		return setRole(tx, &u, role, emit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// CreateOwnerApplication ยื่นคำขอของ a.UserID (ค้างได้ทีละหนึ่งคำขอ)
+func (r *UserRepo) CreateOwnerApplication(ctx context.Context, a *domain.OwnerApplication) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var u domain.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&u, "id = ?", a.UserID).Error; err != nil {
+			return err
+		}
+		if u.Role != domain.RoleUser {
+			return ErrNotApplicable
+		}
+		var pending int64
+		if err := tx.Model(&domain.OwnerApplication{}).
+			Where("user_id = ? AND status = ?", a.UserID, domain.ApplicationPending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return ErrApplicationPending
+		}
+		a.ID, a.Status = uuid.NewString(), domain.ApplicationPending
+		return tx.Create(a).Error
+	})
+}
+
+// ReviewOwnerApplication ปิดคำขอ id; อนุมัติ = role เป็น OWNER ใน txn เดียวกัน
+// (ผู้สมัครที่กลายเป็น ADMIN ไปก่อนแล้วไม่ถูกลดสิทธิ์)
+func (r *UserRepo) ReviewOwnerApplication(ctx context.Context, id string, approve bool, reviewer, note string, emit Emit) (*domain.OwnerApplication, error) {
+	var a domain.OwnerApplication
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&a, "id = ?", id).Error; err != nil {
+			return err
+		}
+		if a.Status != domain.ApplicationPending {
+			return ErrApplicationClosed
+		}
+		now := time.Now().UTC()
+		a.Status, a.ReviewedBy, a.ReviewNote, a.ReviewedAt = domain.ApplicationRejected, reviewer, note, &now
+		if approve {
+			a.Status = domain.ApplicationApproved
+		}
+		if err := tx.Save(&a).Error; err != nil {
+			return err
+		}
+		if !approve {
+			return nil
+		}
+		var u domain.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&u, "id = ?", a.UserID).Error; err != nil {
+			return err
+		}
+		if u.Role == domain.RoleAdmin {
+			return nil
+		}
+		return setRole(tx, &u, domain.RoleOwner, emit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// OwnerApplications รายการคำขอ ใหม่สุดก่อน (userID/status ว่าง = ไม่กรอง)
+func (r *UserRepo) OwnerApplications(ctx context.Context, page, size int32, userID, status string) ([]domain.OwnerApplication, int64, error) {
+	if size <= 0 {
+		size = 20
+	}
+	if page < 0 {
+		page = 0
+	}
+	qb := r.db.WithContext(ctx).Model(&domain.OwnerApplication{})
+	if userID != "" {
+		qb = qb.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		qb = qb.Where("status = ?", strings.ToUpper(status))
+	}
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var out []domain.OwnerApplication
+	if err := qb.Order("created_at DESC").Limit(int(size)).Offset(int(page * size)).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
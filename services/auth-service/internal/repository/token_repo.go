@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/services/auth-service/internal/domain"
+)
+
+var (
+	ErrRefreshInvalid = errors.New("refresh token is invalid or expired")
+	ErrRefreshReused  = errors.New("refresh token was already used")
+)
+
+func (r *UserRepo) CreateRefreshToken(ctx context.Context, t *domain.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(t).Error
+}
+
+// RotateRefreshToken ใช้ token ที่ hash = tokenHash แล้วบันทึก next (family/user เดียวกัน) แทน
+// token ที่ใช้ไปแล้วถูกส่งมาอีก = เพิกถอนทั้ง family แล้วคืน ErrRefreshReused
+func (r *UserRepo) RotateRefreshToken(ctx context.Context, tokenHash string, next *domain.RefreshToken) error {
+	var reused bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cur domain.RefreshToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&cur, "token_hash = ?", tokenHash).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrRefreshInvalid
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		switch {
+		case cur.RevokedAt != nil || !now.Before(cur.ExpiresAt):
+			return ErrRefreshInvalid
+		case cur.UsedAt != nil:
+			reused = true
+			return revokeFamily(tx, cur.FamilyID, now)
+		}
+		if err := tx.Model(&cur).Update("used_at", now).Error; err != nil {
+			return err
+		}
+		next.FamilyID, next.UserID = cur.FamilyID, cur.UserID
+		return tx.Create(next).Error
+	})
+	if err == nil && reused {
+		return ErrRefreshReused
+	}
+	return err
+}
+
+// RevokeRefreshFamily เพิกถอน family ของ token (logout); token ที่ไม่รู้จักถือว่าสำเร็จ
+func (r *UserRepo) RevokeRefreshFamily(ctx context.Context, tokenHash string) error {
+	db := r.db.WithContext(ctx)
+	var cur domain.RefreshToken
+	err := db.First(&cur, "token_hash = ?", tokenHash).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return revokeFamily(db, cur.FamilyID, time.Now().UTC())
+}
+
+func revokeFamily(tx *gorm.DB, familyID string, now time.Time) error {
+	return tx.Model(&domain.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now).Error
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/you/badminton-booking/pkg/outbox"
 	"github.com/you/badminton-booking/services/auth-service/internal/domain"
 
 	"github.com/google/uuid"
@@ -16,7 +17,10 @@ func NewUserRepo(db *gorm.DB) *UserRepo {
 }
 
 func (r *UserRepo) Migrate() error {
-	return r.db.AutoMigrate(&domain.User{})
+	if err := r.db.AutoMigrate(&domain.User{}, &domain.RefreshToken{}, &domain.OwnerApplication{}); err != nil {
+		return err
+	}
+	return outbox.Migrate(r.db)
 }
 
 func (r *UserRepo) Create(ctx context.Context, u *domain.User) error {
@@ -2,8 +2,19 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
 	"time"
 
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
 	"github.com/you/badminton-booking/pkg/auth"
 	"github.com/you/badminton-booking/services/auth-service/internal/domain"
 	"github.com/you/badminton-booking/services/auth-service/internal/repository"
@@ -11,25 +22,103 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-type AuthSvc struct{ repo *repository.UserRepo }
+type AuthSvc struct {
+	repo       *repository.UserRepo
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
 
-func NewAuthSvc(r *repository.UserRepo) *AuthSvc { return &AuthSvc{repo: r} }
+func NewAuthSvc(r *repository.UserRepo, accessTTL, refreshTTL time.Duration) *AuthSvc {
+	return &AuthSvc{repo: r, accessTTL: accessTTL, refreshTTL: refreshTTL}
+}
 
-func (s *AuthSvc) Register(ctx context.Context, email, password, name, role string) (*domain.User, error) {
+// Register สร้างบัญชี USER เสมอ; OWNER ต้องยื่นคำขอ (ApplyForOwner) ส่วน ADMIN กำหนดได้ทาง AssignRole เท่านั้น
+func (s *AuthSvc) Register(ctx context.Context, email, password, name string) (*domain.User, error) {
 	hash, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	u := &domain.User{Email: email, PasswordHash: string(hash), Name: name, Role: domain.Role(role)}
+	u := &domain.User{Email: email, PasswordHash: string(hash), Name: name, Role: domain.RoleUser}
 	return u, s.repo.Create(ctx, u)
 }
 
 func (s *AuthSvc) Login(ctx context.Context, email, password string) (*domain.User, string, string, error) {
 	u, err := s.repo.ByEmail(ctx, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "", "", status.Error(codes.Unauthenticated, "invalid email or password")
+	}
 	if err != nil {
 		return nil, "", "", err
 	}
 	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		return nil, "", "", status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+	access, err := auth.CreateAccessToken(u.ID, string(u.Role), u.Email, s.accessTTL)
+	if err != nil {
+		return nil, "", "", err
+	}
+	// login ใหม่ = family ใหม่
+	refresh, rt := s.newRefresh(u.ID, uuid.NewString())
+	if err := s.repo.CreateRefreshToken(ctx, rt); err != nil {
+		return nil, "", "", err
+	}
+	return u, access, refresh, nil
+}
+
+// RefreshToken แลก refresh token เป็นคู่ใหม่ (ตัวเดิมใช้ไม่ได้อีก)
+// ตัวที่ใช้ไปแล้วถูกส่งมาอีก = token รั่ว: เพิกถอนทุกตัวของ login นั้น ต้อง login ใหม่
+func (s *AuthSvc) RefreshToken(ctx context.Context, token string) (*domain.User, string, string, error) {
+	if token == "" {
+		return nil, "", "", status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+	refresh, next := s.newRefresh("", "")
+	err := s.repo.RotateRefreshToken(ctx, hashToken(token), next)
+	switch {
+	case errors.Is(err, repository.ErrRefreshReused):
+		log.Printf("[auth] refresh token reuse detected; token family revoked")
+		return nil, "", "", status.Error(codes.Unauthenticated, "refresh token was already used; please log in again")
+	case errors.Is(err, repository.ErrRefreshInvalid):
+		return nil, "", "", status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		return nil, "", "", err
+	}
+
+	// อ่าน user ใหม่ทุกครั้ง: role ที่เปลี่ยนมีผลใน access token ถัดไป
+	u, err := s.repo.ByID(ctx, next.UserID)
+	if err != nil {
+		return nil, "", "", err
+	}
+	access, err := auth.CreateAccessToken(u.ID, string(u.Role), u.Email, s.accessTTL)
+	if err != nil {
 		return nil, "", "", err
 	}
-	access, _ := auth.CreateAccessToken(u.ID, string(u.Role), u.Email, time.Duration(60)*time.Minute)
-	refresh, _ := auth.CreateAccessToken(u.ID, string(u.Role), u.Email, time.Duration(720)*time.Hour)
 	return u, access, refresh, nil
 }
+
+// Logout เพิกถอน refresh token และทุกตัวที่หมุนมาจาก login เดียวกัน
+// access token ที่ออกไปแล้วยังใช้ได้จนหมดอายุ (อายุสั้น)
+func (s *AuthSvc) Logout(ctx context.Context, token string) error {
+	if token == "" {
+		return status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+	return s.repo.RevokeRefreshFamily(ctx, hashToken(token))
+}
+
+// newRefresh สุ่ม token ใหม่ คืน token (ให้ client) กับแถวที่เก็บแค่ hash
+func (s *AuthSvc) newRefresh(userID, familyID string) (string, *domain.RefreshToken) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err) // crypto/rand ไม่ควรล้มเหลว
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, &domain.RefreshToken{
+		ID:        uuid.NewString(),
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().UTC().Add(s.refreshTTL),
+	}
+}
+
+// hashToken token สุ่ม 256 bit จึงใช้ sha256 ตรง ๆ ได้ (ไม่ต้อง bcrypt)
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/auth-service/internal/domain"
+	"github.com/you/badminton-booking/services/auth-service/internal/repository"
+)
+
+// emitRoleChanged user.role_changed ให้ user-service อัปเดต role ตาม
+func emitRoleChanged(ctx context.Context, changedBy, reason string) repository.Emit {
+	return func(u *domain.User, old domain.Role) []outbox.Event {
+		return []outbox.Event{{Key: events.RKUserRoleChanged, Payload: events.New(ctx, events.RKUserRoleChanged, events.UserRoleChanged{
+			UserID: u.ID, Email: u.Email, Name: u.Name,
+			OldRole: string(old), NewRole: string(u.Role),
+			ChangedBy: changedBy, Reason: reason,
+		})}}
+	}
+}
+
+// roleStatus แปลง error ของ repository เป็น gRPC status
+func roleStatus(err error, what string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", what)
+	case errors.Is(err, repository.ErrApplicationPending):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrApplicationClosed), errors.Is(err, repository.ErrNotApplicable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// AssignRole ADMIN (adminID) กำหนด role ของ userID; role เดิมอยู่แล้ว = ไม่มี event
+func (s *AuthSvc) AssignRole(ctx context.Context, adminID, userID, role string) (*domain.User, error) {
+	r, ok := domain.ParseRole(strings.ToUpper(strings.TrimSpace(role)))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", role)
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if userID == adminID && r != domain.RoleAdmin {
+		// กันระบบไม่เหลือ ADMIN เพราะลดสิทธิ์ตัวเอง
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot remove their own ADMIN role")
+	}
+	u, err := s.repo.SetRole(ctx, userID, r, emitRoleChanged(ctx, adminID, "assigned by admin"))
+	if err != nil {
+		return nil, roleStatus(err, "user")
+	}
+	return u, nil
+}
+
+// ApplyForOwner USER ยื่นขอเป็นเจ้าของสนาม (รอ ADMIN อนุมัติ)
+func (s *AuthSvc) ApplyForOwner(ctx context.Context, userID, businessName, note string) (*domain.OwnerApplication, error) {
+	businessName = strings.TrimSpace(businessName)
+	if businessName == "" {
+		return nil, status.Error(codes.InvalidArgument, "business_name is required")
+	}
+	a := &domain.OwnerApplication{UserID: userID, BusinessName: businessName, Note: strings.TrimSpace(note)}
+	if err := s.repo.CreateOwnerApplication(ctx, a); err != nil {
+		return nil, roleStatus(err, "user")
+	}
+	return a, nil
+}
+
+// ReviewOwnerApplication ADMIN อนุมัติ (role เป็น OWNER) หรือปฏิเสธคำขอ
+func (s *AuthSvc) ReviewOwnerApplication(ctx context.Context, adminID, id string, approve bool, note string) (*domain.OwnerApplication, error) {
+	a, err := s.repo.ReviewOwnerApplication(ctx, id, approve, adminID, strings.TrimSpace(note),
+		emitRoleChanged(ctx, adminID, "owner application approved"))
+	if err != nil {
+		return nil, roleStatus(err, "owner application")
+	}
+	return a, nil
+}
+
+func (s *AuthSvc) ListOwnerApplications(ctx context.Context, page, size int32, userID, st string) ([]domain.OwnerApplication, int64, error) {
+	return s.repo.OwnerApplications(ctx, page, size, userID, st)
+}
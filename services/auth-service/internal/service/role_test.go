@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/services/auth-service/internal/domain"
+)
+
+// emitRoleChanged ผ่าน outbox แบบ relay แล้วอ่านด้วย events.Decode แบบ user-service / notification-service
+func TestRoleChangedContract(t *testing.T) {
+	u := &domain.User{ID: "u1", Email: "owner@example.com", Name: "Owner", Role: domain.RoleOwner}
+	evs := emitRoleChanged(context.Background(), "admin1", "owner application approved")(u, domain.RoleUser)
+	if len(evs) != 1 || evs[0].Key != events.RKUserRoleChanged {
+		t.Fatalf("got %+v", evs)
+	}
+	body, err := json.Marshal(evs[0].Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, err := events.Decode[events.UserRoleChanged](body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := events.UserRoleChanged{
+		UserID: "u1", Email: "owner@example.com", Name: "Owner",
+		OldRole: "USER", NewRole: "OWNER", ChangedBy: "admin1", Reason: "owner application approved",
+	}
+	if ev.Type != events.RKUserRoleChanged || ev.Data != want {
+		t.Fatalf("got %s %+v, want %+v", ev.Type, ev.Data, want)
+	}
+}
@@ -2,9 +2,15 @@ package grpc
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/you/badminton-booking/pkg/auth"
 	authv1 "github.com/you/badminton-booking/proto/auth/v1"
+	"github.com/you/badminton-booking/services/auth-service/internal/domain"
 	"github.com/you/badminton-booking/services/auth-service/internal/service"
 )
 
@@ -18,7 +24,7 @@ func NewServer(s *service.AuthSvc) *Server {
 }
 
 func (s *Server) Register(ctx context.Context, in *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
-	u, err := s.svc.Register(ctx, in.Email, in.Password, in.Name, in.Role)
+	u, err := s.svc.Register(ctx, in.Email, in.Password, in.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +47,110 @@ func (s *Server) ValidateToken(ctx context.Context, in *authv1.ValidateTokenRequ
 	}
 	return &authv1.ValidateTokenResponse{UserId: claims.Sub, Role: string(claims.Role), Valid: true}, nil
 }
+
+func (s *Server) RefreshToken(ctx context.Context, in *authv1.RefreshTokenRequest) (*authv1.RefreshTokenResponse, error) {
+	u, at, rt, err := s.svc.RefreshToken(ctx, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.RefreshTokenResponse{AccessToken: at, RefreshToken: rt, User: &authv1.User{Id: u.ID, Email: u.Email, Name: u.Name, Role: string(u.Role)}}, nil
+}
+
+func (s *Server) Logout(ctx context.Context, in *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	if err := s.svc.Logout(ctx, in.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &authv1.LogoutResponse{}, nil
+}
+
+func first(ss []string) string {
+	if len(ss) > 0 {
+		return ss[0]
+	}
+	return ""
+}
+
+// caller ตัวตนของผู้เรียกจาก metadata ที่ gateway แนบมา (x-user-id / x-user-role)
+func caller(ctx context.Context) (id, role string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md.Get("x-user-id")), first(md.Get("x-user-role"))
+}
+
+// requireAdmin gateway ตรวจ role แล้ว ตรวจซ้ำที่นี่เผื่อถูกเรียกตรง
+func requireAdmin(ctx context.Context) (string, error) {
+	id, role := caller(ctx)
+	if id == "" {
+		return "", status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	if role != string(domain.RoleAdmin) {
+		return "", status.Error(codes.PermissionDenied, "admin only")
+	}
+	return id, nil
+}
+
+func applicationToPB(a *domain.OwnerApplication) *authv1.OwnerApplication {
+	pb := &authv1.OwnerApplication{
+		Id: a.ID, UserId: a.UserID, BusinessName: a.BusinessName, Note: a.Note, Status: a.Status,
+		ReviewedBy: a.ReviewedBy, ReviewNote: a.ReviewNote, CreatedAtIso: a.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if a.ReviewedAt != nil {
+		pb.ReviewedAtIso = a.ReviewedAt.UTC().Format(time.RFC3339)
+	}
+	return pb
+}
+
+func (s *Server) AssignRole(ctx context.Context, in *authv1.AssignRoleRequest) (*authv1.AssignRoleResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.svc.AssignRole(ctx, adminID, in.UserId, in.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.AssignRoleResponse{User: &authv1.User{Id: u.ID, Email: u.Email, Name: u.Name, Role: string(u.Role)}}, nil
+}
+
+func (s *Server) ApplyForOwner(ctx context.Context, in *authv1.ApplyForOwnerRequest) (*authv1.ApplyForOwnerResponse, error) {
+	id, _ := caller(ctx)
+	if id == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	a, err := s.svc.ApplyForOwner(ctx, id, in.BusinessName, in.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.ApplyForOwnerResponse{Application: applicationToPB(a)}, nil
+}
+
+func (s *Server) ListOwnerApplications(ctx context.Context, in *authv1.ListOwnerApplicationsRequest) (*authv1.ListOwnerApplicationsResponse, error) {
+	id, role := caller(ctx)
+	if id == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	userID := in.UserId
+	if role != string(domain.RoleAdmin) {
+		userID = id
+	}
+	list, total, err := s.svc.ListOwnerApplications(ctx, in.Page, in.PageSize, userID, in.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authv1.ListOwnerApplicationsResponse{Total: total}
+	for i := range list {
+		resp.Applications = append(resp.Applications, applicationToPB(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) ReviewOwnerApplication(ctx context.Context, in *authv1.ReviewOwnerApplicationRequest) (*authv1.ReviewOwnerApplicationResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.svc.ReviewOwnerApplication(ctx, adminID, in.Id, in.Approve, in.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.ReviewOwnerApplicationResponse{Application: applicationToPB(a)}, nil
+}
@@ -7,16 +7,22 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/you/badminton-booking/pkg/db"
+	"github.com/you/badminton-booking/pkg/events"
 	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/pkg/outbox"
 	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
 	cons "github.com/you/badminton-booking/services/booking-service/internal/consumer"
 	"github.com/you/badminton-booking/services/booking-service/internal/repository"
 	"github.com/you/badminton-booking/services/booking-service/internal/service"
+	"github.com/you/badminton-booking/services/booking-service/internal/sweeper"
 	tgrpc "github.com/you/badminton-booking/services/booking-service/internal/transport/grpc"
 )
 
@@ -24,6 +30,29 @@ type Cfg struct {
 	PGBookingDSN    string `envconfig:"PG_BOOKING_DSN" required:"true"`
 	BookingGRPCAddr string `envconfig:"BOOKING_GRPC_ADDR" default:":50053"`
 
+	// court-service (เวลาเปิด-ปิด/ราคา ของสนาม)
+	CourtGRPCAddr string `envconfig:"COURT_GRPC_ADDR" default:":50052"`
+	// เขตเวลาของสนาม ใช้ตีความ OpenFrom/OpenTo และวันที่ใน availability
+	BookingTZ string `envconfig:"BOOKING_TZ" default:"Asia/Bangkok"`
+	// เวลาเริ่ม/จบ booking ต้องลงตัวกับหน่วยนี้ (นาที)
+	SlotMinutes int `envconfig:"BOOKING_SLOT_MINUTES" default:"30"`
+	// PENDING ครองช่องไว้ได้นานเท่านี้ก่อนถูกเปลี่ยนเป็น EXPIRED
+	HoldTTL time.Duration `envconfig:"BOOKING_HOLD_TTL" default:"15m"`
+	// แบ่งจ่าย: ขยาย hold ให้ผู้ร่วมหารจ่ายได้อีกเท่านี้ (ไม่เกินเวลาเริ่ม)
+	SplitHoldTTL time.Duration `envconfig:"BOOKING_SPLIT_HOLD_TTL" default:"2h"`
+	// ครั้งที่ 2 เป็นต้นไปของ series จ่ายได้จนถึงก่อนเริ่มเท่านี้ (ครองช่องไว้จนถึงตอนนั้น)
+	SeriesPayLead time.Duration `envconfig:"BOOKING_SERIES_PAY_LEAD" default:"24h"`
+	SweepInterval time.Duration `envconfig:"BOOKING_SWEEP_INTERVAL" default:"1m"`
+	// สกุลเงินของราคาที่คิดจาก PricePerHour
+	Currency string `envconfig:"BOOKING_CURRENCY" default:"THB"`
+	// ยอดต่ำสุดที่ gateway รับ (สตางค์; Omise THB = 20 บาท) ส่วนลดโปรโมชันหักได้ไม่ต่ำกว่านี้
+	MinChargeAmount int64 `envconfig:"BOOKING_MIN_CHARGE" default:"2000"`
+	// payment.failed: ยกเลิก PENDING เมื่อล้มเหลวครบจำนวนนี้ หรือเจอ failure code ในรายการ (คั่นด้วย ,)
+	MaxPaymentFailures   int      `envconfig:"BOOKING_MAX_PAYMENT_FAILURES" default:"3"`
+	TerminalFailureCodes []string `envconfig:"BOOKING_TERMINAL_FAILURE_CODES" default:"stolen_or_lost_card,failed_fraud_check,invalid_account_number"`
+	// ยกเลิก booking ที่จ่ายแล้ว: <ก่อนเริ่มอย่างน้อย>:<% คืน> คั่นด้วย , (ไม่เข้า tier ไหน = ไม่คืน)
+	RefundPolicy string `envconfig:"BOOKING_REFUND_POLICY" default:"24h:100,0s:50"`
+
 	// RabbitMQ for consuming payment events
 	RabbitURL       string `envconfig:"RABBIT_URL" required:"true"`
 	PaymentExchange string `envconfig:"PAYMENT_EXCHANGE" default:"payment.exchange"`
@@ -31,6 +60,8 @@ type Cfg struct {
 
 	// RabbitMQ for publishing booking events (e.g. booking.confirmed)
 	BookingExchange string `envconfig:"BOOKING_EXCHANGE" default:"booking.exchange"`
+	// ความถี่ที่ relay ดึง outbox ไป publish
+	OutboxInterval time.Duration `envconfig:"BOOKING_OUTBOX_INTERVAL" default:"1s"`
 }
 
 func must[T any](v T, err error) T {
@@ -49,24 +80,47 @@ func main() {
 	repo := repository.NewBookingRepo(gdb)
 	must(0, repo.Migrate())
 
-	// Publisher (สำหรับปล่อย booking.* events)
+	// Publisher (relay ส่ง booking.* events จาก outbox)
 	bookingPub := must(mq.NewPublisher(cfg.RabbitURL, cfg.BookingExchange))
 	defer bookingPub.Close()
 
+	// court-service client
+	courtConn := must(grpc.NewClient(cfg.CourtGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer courtConn.Close()
+	courtCli := courtv1.NewCourtServiceClient(courtConn)
+
 	// gRPC server ของ booking-service
-	svc := service.NewBookingSvc(repo, bookingPub) // ✅ ตอนนี้ส่งครบ 2 อาร์กิวเมนต์แล้ว
+	svc := service.NewBookingSvc(repo, courtCli, service.Options{
+		Location:        must(time.LoadLocation(cfg.BookingTZ)),
+		SlotGranularity: time.Duration(cfg.SlotMinutes) * time.Minute,
+		HoldTTL:         cfg.HoldTTL,
+		SplitHoldTTL:    cfg.SplitHoldTTL,
+		SeriesPayLead:   cfg.SeriesPayLead,
+		Currency:        cfg.Currency,
+		MinChargeAmount: cfg.MinChargeAmount,
+
+		MaxPaymentFailures:   cfg.MaxPaymentFailures,
+		TerminalFailureCodes: cfg.TerminalFailureCodes,
+		RefundPolicy:         must(service.ParseRefundPolicy(cfg.RefundPolicy)),
+	})
 	lis := must(net.Listen("tcp", cfg.BookingGRPCAddr))
-	gs := grpc.NewServer()
+	gs := grpc.NewServer(grpc.UnaryInterceptor(tgrpc.ActorInterceptor))
 	bookingv1.RegisterBookingServiceServer(gs, tgrpc.NewServer(svc))
 
-	// Consumer (ฟัง payment.paid)
+	// Consumer (ฟัง payment.paid / payment.failed)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	paymentCons := must(mq.NewConsumer(cfg.RabbitURL, cfg.PaymentExchange, cfg.PaymentQueue, []string{"payment.paid"}))
+	paymentCons := must(mq.NewConsumer(cfg.RabbitURL, cfg.PaymentExchange, cfg.PaymentQueue, []string{events.RKPaymentPaid, events.RKPaymentFailed}))
 	defer paymentCons.Close()
-	pc := cons.NewPaymentConsumer(repo, paymentCons)
+	pc := cons.NewPaymentConsumer(svc, paymentCons)
 	must(0, pc.Run(ctx))
-	log.Println("[booking] consumer started (payment.paid)")
+	log.Println("[booking] consumer started (payment.paid, payment.failed)")
+
+	// Outbox relay (booking.* events -> RabbitMQ)
+	go outbox.NewRelay(gdb, bookingPub, cfg.OutboxInterval).Run(ctx)
+
+	// Sweeper (PENDING หมดเวลา hold -> EXPIRED)
+	go sweeper.NewExpirySweeper(svc, cfg.SweepInterval).Run(ctx)
 
 	// start gRPC
 	go func() {
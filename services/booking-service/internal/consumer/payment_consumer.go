@@ -2,33 +2,25 @@ package consumer
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"log"
 
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/pkg/events"
 	"github.com/you/badminton-booking/pkg/mq"
-	"github.com/you/badminton-booking/services/booking-service/internal/repository"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+	"github.com/you/badminton-booking/services/booking-service/internal/service"
 )
 
-type PaymentPaid struct {
-	Event   string `json:"event"`   // "payment.paid"
-	Version int    `json:"version"` // 1
-	Data    struct {
-		PaymentID string `json:"payment_id"`
-		BookingID string `json:"booking_id"`
-		Amount    int64  `json:"amount"`
-		Currency  string `json:"currency"`
-		Method    string `json:"method"`
-		IdemKey   string `json:"idempotency_key"`
-	} `json:"data"`
-}
-
 type PaymentConsumer struct {
-	repo *repository.BookingRepo
+	svc  *service.BookingSvc
 	cons *mq.Consumer
 }
 
-func NewPaymentConsumer(repo *repository.BookingRepo, cons *mq.Consumer) *PaymentConsumer {
-	return &PaymentConsumer{repo: repo, cons: cons}
+func NewPaymentConsumer(svc *service.BookingSvc, cons *mq.Consumer) *PaymentConsumer {
+	return &PaymentConsumer{svc: svc, cons: cons}
 }
 
 func (pc *PaymentConsumer) Run(ctx context.Context) error {
@@ -39,24 +31,73 @@ func (pc *PaymentConsumer) Run(ctx context.Context) error {
 	go func() {
 		for d := range msgs {
 			switch d.RoutingKey {
-			case "payment.paid":
-				var evt PaymentPaid
-				if err := json.Unmarshal(d.Body, &evt); err != nil {
-					log.Printf("[booking-consumer] unmarshal error: %v", err)
+			case events.RKPaymentPaid:
+				evt, err := events.Decode[events.PaymentPaid](d.Body)
+				if err != nil {
+					log.Printf("[booking-consumer] %v", err)
 					_ = d.Nack(false, false)
 					continue
 				}
+				ctx := evt.Context(ctx)
 				if evt.Data.BookingID == "" || evt.Data.PaymentID == "" {
 					log.Printf("[booking-consumer] invalid event payload")
 					_ = d.Ack(false)
 					continue
 				}
-				if _, err := pc.repo.ConfirmIfNotProcessed(ctx, evt.Data.BookingID, evt.Data.PaymentID, "payment.paid"); err != nil {
+				if evt.Data.ShareID != "" {
+					pc.sharePaid(ctx, d, evt.Data)
+					continue
+				}
+				b, err := pc.svc.PaymentPaid(ctx, evt.Data)
+				if errors.Is(err, domain.ErrIllegalTransition) {
+					// จ่ายเข้ามาหลัง booking ถูกยกเลิก/หมดเวลา: ไม่ confirm, booking.payment_rejected ให้ payment-service คืนเงิน
+					log.Printf("[booking-consumer] payment %s for %s booking %s rejected for refund: %v", evt.Data.PaymentID, b.Status, b.ID, err)
+					_ = d.Ack(false)
+					continue
+				}
+				if err != nil {
 					log.Printf("[booking-consumer] confirm error: %v", err)
 					_ = d.Nack(false, true)
 					continue
 				}
 				_ = d.Ack(false)
+			case events.RKPaymentFailed:
+				evt, err := events.Decode[events.PaymentFailed](d.Body)
+				if err != nil {
+					log.Printf("[booking-consumer] %v", err)
+					_ = d.Nack(false, false)
+					continue
+				}
+				ctx := evt.Context(ctx)
+				bookingID, paymentID, code := evt.Data.BookingID, evt.Data.PaymentID, evt.Data.FailureCode
+				if bookingID == "" {
+					log.Printf("[booking-consumer] invalid event payload")
+					_ = d.Ack(false)
+					continue
+				}
+				if evt.Data.ShareID != "" {
+					// ส่วนของการหารจ่าย: คนนั้นจ่ายใหม่ได้จนกว่า hold หมด ไม่นับเป็นความล้มเหลวของ booking
+					log.Printf("[booking-consumer] payment for share %s of booking %s failed: %s", evt.Data.ShareID, bookingID, code)
+					_ = d.Ack(false)
+					continue
+				}
+				if paymentID == "" {
+					// สร้าง charge ไม่สำเร็จ (เช่น create_charge_error, network): ไม่มี charge ให้กันซ้ำและไม่ใช่การปฏิเสธจาก bank จึงไม่นับ
+					log.Printf("[booking-consumer] payment attempt for booking %s failed before a charge existed: %s", bookingID, code)
+					_ = d.Ack(false)
+					continue
+				}
+				if _, err := pc.svc.PaymentFailed(ctx, bookingID, "payment.failed:"+paymentID, code); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						log.Printf("[booking-consumer] payment.failed for unknown booking %s", bookingID)
+						_ = d.Ack(false)
+						continue
+					}
+					log.Printf("[booking-consumer] payment failure error: %v", err)
+					_ = d.Nack(false, true)
+					continue
+				}
+				_ = d.Ack(false)
 			default:
 				// ignore others
 				_ = d.Ack(false)
@@ -65,3 +106,20 @@ func (pc *PaymentConsumer) Run(ctx context.Context) error {
 	}()
 	return nil
 }
+
+// sharePaid บันทึกการจ่ายของส่วนหนึ่งใน booking หารจ่าย
+func (pc *PaymentConsumer) sharePaid(ctx context.Context, d amqp.Delivery, p events.PaymentPaid) {
+	b, err := pc.svc.SharePaid(ctx, p)
+	switch {
+	case errors.Is(err, domain.ErrIllegalTransition):
+		// ส่วนถูกแบ่งใหม่/ยกเลิก หรือ booking ไม่รอเงินแล้ว: booking.payment_rejected ให้ payment-service คืนเงิน
+		log.Printf("[booking-consumer] payment %s for share %s of %s booking %s rejected for refund: %v", p.PaymentID, p.ShareID, b.Status, b.ID, err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		log.Printf("[booking-consumer] payment %s for share %s of unknown booking %s", p.PaymentID, p.ShareID, p.BookingID)
+	case err != nil:
+		log.Printf("[booking-consumer] share payment error: %v", err)
+		_ = d.Nack(false, true)
+		return
+	}
+	_ = d.Ack(false)
+}
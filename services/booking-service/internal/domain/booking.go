@@ -3,16 +3,60 @@ package domain
 import "time"
 
 type Booking struct {
-	ID        string    `gorm:"primaryKey"`
-	UserID    string    `gorm:"index"`
-	CourtID   string    `gorm:"index"`
-	StartTime time.Time `gorm:"index"`
-	EndTime   time.Time `gorm:"index"`
-	Status    string    `gorm:"index"` // PENDING|CONFIRMED|CANCELLED
+	ID        string     `gorm:"primaryKey"`
+	UserID    string     `gorm:"index"`
+	CourtID   string     `gorm:"index"`
+	StartTime time.Time  `gorm:"index"`
+	EndTime   time.Time  `gorm:"index"`
+	Status    string     `gorm:"index"` // ดู status.go
+	ExpiresAt *time.Time `gorm:"index"` // hold ของ PENDING; เลยเวลานี้ถือว่าช่องว่าง
+	Amount    int64      // ราคาที่ server คำนวณตอนจอง หลังหักส่วนลด (สตางค์)
+	Currency  string
+	SeriesID  string `gorm:"index"` // ว่าง = booking เดี่ยว
+	// PromoCode โค้ดส่วนลดที่ใช้ (ดู PromoRedemption); Discount = ส่วนลดที่หักจาก Amount แล้ว
+	PromoCode string
+	Discount  int64
+	// PaymentID charge ที่ทำให้ CONFIRMED (ว่าง = ยังไม่จ่าย หรือ confirm มือ) ใช้คืนเงินตอนยกเลิก
+	PaymentID string
+	// การชำระเงินที่ล้มเหลว (จาก payment.failed) ครบจำนวนที่กำหนดจะถูกยกเลิกอัตโนมัติ
+	PaymentFailures  int
+	LastPaymentError string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	// Shares ส่วนแบ่งของ booking หารจ่าย (repository โหลดให้เฉพาะที่ต้องใช้ ไม่ใช่ association)
+	Shares []PaymentShare `gorm:"-"`
+}
+
+// HoldExpired PENDING ที่เลย expires_at แล้ว: holdsSlot ปล่อยช่องนี้ให้คนอื่นจองได้แล้ว
+// ถือเป็น EXPIRED แม้ sweeper ยังไม่มาเปลี่ยนสถานะ (ห้าม confirm)
+func (b *Booking) HoldExpired(now time.Time) bool {
+	return b.Status == StatusPending && b.ExpiresAt != nil && !b.ExpiresAt.After(now)
+}
+
+// สถานะของ BookingSeries (แยกจากสถานะของ booking)
+const (
+	SeriesActive    = "ACTIVE"
+	SeriesCancelled = "CANCELLED"
+)
+
+// BookingSeries แม่ของ booking ซ้ำรายสัปดาห์ (เช่น ทุกวันอังคาร 19:00-21:00 ทั้งเทอม)
+type BookingSeries struct {
+	ID        string `gorm:"primaryKey"`
+	UserID    string `gorm:"index"`
+	CourtID   string `gorm:"index"`
+	Rule      string // RRULE เช่น FREQ=WEEKLY;INTERVAL=1;COUNT=12
+	Status    string // Series*
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// Slot ช่วงเวลาหนึ่งของสนามในวันนั้น (ว่าง/ไม่ว่าง) ใช้ตอบ availability
+type Slot struct {
+	Start time.Time
+	End   time.Time
+	Free  bool
+}
+
 type EventConsumed struct {
 	ID          string `gorm:"primaryKey"` // event unique id (e.g. payment_id or composed key)
 	EventKey    string `gorm:"index"`      // e.g. payment.paid
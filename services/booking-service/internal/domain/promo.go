@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// ชนิดส่วนลดของ Promotion
+const (
+	PromoPercent = "PERCENT" // Value = เปอร์เซ็นต์ (1-100)
+	PromoFixed   = "FIXED"   // Value = สตางค์
+)
+
+// Promotion โค้ดส่วนลดที่ใช้ตอนจอง (ADMIN สร้าง)
+// ช่วงที่ใช้โค้ดได้คือ [StartsAt, EndsAt) ส่วน Weekdays/TimeFrom/TimeTo จำกัดช่วงเวลาเล่นของ booking (เวลาท้องถิ่นของสนาม)
+type Promotion struct {
+	Code        string `gorm:"primaryKey"` // ตัวพิมพ์ใหญ่เสมอ
+	Description string
+	Kind        string // Promo*
+	Value       int64
+	MaxDiscount int64 // PERCENT: เพดานส่วนลด (สตางค์), 0 = ไม่จำกัด
+	MinAmount   int64 // ราคาก่อนลดขั้นต่ำ (สตางค์)
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	Weekdays    string // "1,2,3,4,5" (1 = จันทร์ ... 7 = อาทิตย์); ว่าง = ทุกวัน
+	TimeFrom    string // HH:mm; ว่างทั้งคู่ = ทั้งวัน
+	TimeTo      string
+	// จำนวนครั้งนับทั้งที่จองไว้ (RESERVED) และจ่ายแล้ว (REDEEMED); 0 = ไม่จำกัด
+	UsageLimit     int
+	PerUserLimit   int
+	NewMembersOnly bool   // เฉพาะผู้ที่ยังไม่เคยมี booking ที่ชำระแล้ว
+	CourtIDs       string // คั่นด้วย , ; ว่าง = ทุกสนาม
+	Venue          string // ว่าง = ทุก venue
+	Active         bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// สถานะการใช้โค้ดของ booking หนึ่งรายการ
+const (
+	RedemptionReserved = "RESERVED" // booking ยัง PENDING; กันสิทธิ์ไว้แล้ว
+	RedemptionRedeemed = "REDEEMED" // ชำระเงินแล้ว (บันทึกใน txn เดียวกับการ confirm)
+	RedemptionReleased = "RELEASED" // booking ไม่ได้ชำระ (ยกเลิก/หมดเวลา) คืนสิทธิ์
+)
+
+// PromoRedemption การใช้โค้ดของ booking (หนึ่ง booking ใช้ได้หนึ่งโค้ด)
+type PromoRedemption struct {
+	BookingID string `gorm:"primaryKey"`
+	Code      string `gorm:"index"`
+	UserID    string `gorm:"index"`
+	Discount  int64  // สตางค์
+	Status    string `gorm:"index"` // Redemption*
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// สถานะของ PaymentShare
+const (
+	SharePending   = "PENDING"
+	SharePaid      = "PAID"
+	ShareCancelled = "CANCELLED" // แบ่งใหม่ / ผู้จัดจ่ายแทน / booking ไม่รอเงินแล้ว
+)
+
+// PaymentShare ส่วนหนึ่งของค่าสนามใน booking หารจ่าย; แต่ละคนจ่ายส่วนของตัวเองผ่าน charge ปกติ (share_id)
+// booking เป็น CONFIRMED เมื่อทุกส่วนที่ไม่ถูกยกเลิกจ่ายครบก่อน hold หมดอายุ
+type PaymentShare struct {
+	ID        string `gorm:"primaryKey"`
+	BookingID string `gorm:"index"`
+	// ผู้ถูกเชิญ: UserID หรือ Email (user ที่ login ด้วย email นี้ดู booking ได้)
+	UserID    string `gorm:"index"`
+	Email     string `gorm:"index"`
+	Amount    int64  // สตางค์
+	Status    string // Share*
+	Organizer bool   // ส่วนของผู้จัดเอง (รวมถึงส่วนที่จ่ายแทนคนอื่น)
+	PaymentID string // charge ที่จ่ายส่วนนี้
+	PaidAt    *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Splitting b ถูกแบ่งจ่ายอยู่ (มีส่วนที่ยังไม่ถูกยกเลิก)
+func (b *Booking) Splitting() bool {
+	for _, sh := range b.Shares {
+		if sh.Status != ShareCancelled {
+			return true
+		}
+	}
+	return false
+}
+
+// PaidShares ส่วนที่จ่ายแล้วของ b (ต้องโหลด Shares มาก่อน)
+func (b *Booking) PaidShares() []PaymentShare {
+	var out []PaymentShare
+	for _, sh := range b.Shares {
+		if sh.Status == SharePaid {
+			out = append(out, sh)
+		}
+	}
+	return out
+}
+
+// PaidAmount ยอดที่จ่ายแล้วรวมทุกส่วน
+func (b *Booking) PaidAmount() int64 {
+	var n int64
+	for _, sh := range b.PaidShares() {
+		n += sh.Amount
+	}
+	return n
+}
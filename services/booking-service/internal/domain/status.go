@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	StatusPending   = "PENDING"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+	StatusExpired   = "EXPIRED"
+	StatusCompleted = "COMPLETED"
+	StatusNoShow    = "NO_SHOW"
+)
+
+// ErrIllegalTransition เปลี่ยนสถานะที่ state machine ไม่อนุญาต (codes.FailedPrecondition)
+var ErrIllegalTransition = errors.New("illegal_status_transition")
+
+// transitions สถานะถัดไปที่อนุญาตจากแต่ละสถานะ; ที่ไม่อยู่ใน map คือสถานะจบ (terminal)
+var transitions = map[string][]string{
+	StatusPending:   {StatusConfirmed, StatusCancelled, StatusExpired},
+	StatusConfirmed: {StatusCancelled, StatusCompleted, StatusNoShow},
+}
+
+// CanTransition from -> to ถูกต้องตาม state machine หรือไม่
+func CanTransition(from, to string) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition คืน ErrIllegalTransition (พร้อมรายละเอียด) ถ้าเปลี่ยนไม่ได้
+func CheckTransition(from, to string) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, to)
+	}
+	return nil
+}
+
+// CheckBookingTransition เหมือน CheckTransition แต่ PENDING ที่ hold หมดแล้ว (HoldExpired) เปลี่ยนต่อไม่ได้
+func CheckBookingTransition(b *Booking, to string, now time.Time) error {
+	if b.HoldExpired(now) {
+		return fmt.Errorf("%w: hold expired at %s", ErrIllegalTransition, b.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return CheckTransition(b.Status, to)
+}
+
+// BookingStatusHistory บันทึกทุกการเปลี่ยนสถานะของ booking (ใครเปลี่ยน, เพราะอะไร)
+type BookingStatusHistory struct {
+	ID         uint   `gorm:"primaryKey"`
+	BookingID  string `gorm:"index"`
+	FromStatus string // ว่าง = ตอนสร้าง
+	ToStatus   string
+	Actor      string // user id หรือ system:<component>
+	Reason     string
+	CreatedAt  time.Time
+}
+
+func (BookingStatusHistory) TableName() string { return "booking_status_history" }
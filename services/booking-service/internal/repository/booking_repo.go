@@ -3,49 +3,252 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
 	"github.com/you/badminton-booking/services/booking-service/internal/domain"
 )
 
 var ErrOverlap = errors.New("slot_overlapped")
 
+// Emit สร้าง booking.* event ของ booking ที่เพิ่งเปลี่ยน; ถูกเรียกใน txn เดียวกันแล้วเขียนลง outbox
+// nil = ไม่มี event
+type Emit func(b *domain.Booking) []outbox.Event
+
+// Reject สร้าง event คืนเงินของ payment ที่ booking b รับไม่ได้ (why ห่อ domain.ErrIllegalTransition)
+type Reject func(b *domain.Booking, why error) []outbox.Event
+
+func enqueue(tx *gorm.DB, emit Emit, b *domain.Booking) error {
+	if emit == nil {
+		return nil
+	}
+	return outbox.Enqueue(tx, emit(b)...)
+}
+
+// holdsSlot กรองเฉพาะ booking ที่ยังครองช่องเวลา: CONFIRMED หรือ PENDING ที่ hold ยังไม่หมดอายุ
+func holdsSlot(now time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(status = ? OR (status = ? AND (expires_at IS NULL OR expires_at > ?)))", domain.StatusConfirmed, domain.StatusPending, now)
+	}
+}
+
 type BookingRepo struct{ db *gorm.DB }
 
 func NewBookingRepo(db *gorm.DB) *BookingRepo {
 	return &BookingRepo{db: db}
 }
 func (r *BookingRepo) Migrate() error {
-	return r.db.AutoMigrate(&domain.Booking{}, &domain.EventConsumed{})
+	if err := r.db.AutoMigrate(&domain.Booking{}, &domain.BookingSeries{}, &domain.BookingStatusHistory{}, &domain.EventConsumed{},
+		&domain.Promotion{}, &domain.PromoRedemption{}, &domain.PaymentShare{}); err != nil {
+		return err
+	}
+	return outbox.Migrate(r.db)
+}
+
+// recordTransition บันทึกประวัติการเปลี่ยนสถานะ (เรียกใน txn เดียวกับที่เปลี่ยนสถานะ)
+func recordTransition(tx *gorm.DB, bookingID, from, to, actor, reason string) error {
+	return tx.Create(&domain.BookingStatusHistory{
+		BookingID: bookingID, FromStatus: from, ToStatus: to, Actor: actor, Reason: reason,
+	}).Error
+}
+
+// lockOverlap locks any candidate rows that would overlap b (inside tx) to avoid races.
+// Returns ErrOverlap when the slot is already held. b itself (if it has an ID) is ignored.
+func lockOverlap(tx *gorm.DB, b *domain.Booking) error {
+	var existing domain.Booking
+	qb := tx.Model(&domain.Booking{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("court_id = ?", b.CourtID).
+		Scopes(holdsSlot(time.Now().UTC())).
+		Where("start_time < ? AND end_time > ?", b.EndTime, b.StartTime) // overlap condition
+	if b.ID != "" {
+		qb = qb.Where("id <> ?", b.ID)
+	}
+	err := qb.Take(&existing).Error
+
+	if err == nil {
+		return ErrOverlap
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
 }
 
 // CreateWithNoOverlap runs in a txn and prevents overlapping bookings by locking rows.
-func (r *BookingRepo) CreateWithNoOverlap(ctx context.Context, b *domain.Booking) error {
+// b.PromoCode ไม่ว่าง = กันสิทธิ์ใช้โค้ดใน txn เดียวกัน (ErrPromoUnavailable ถ้าเต็ม)
+func (r *BookingRepo) CreateWithNoOverlap(ctx context.Context, b *domain.Booking, emit Emit) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Lock any candidate rows that would overlap to avoid races
-		var existing domain.Booking
-		err := tx.Model(&domain.Booking{}).
-			Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("court_id = ? AND status IN ?", b.CourtID, []string{"PENDING", "CONFIRMED"}).
-			Where("start_time < ? AND end_time > ?", b.EndTime, b.StartTime). // overlap condition
-			Take(&existing).Error
-
-		if err == nil {
+		if err := lockOverlap(tx, b); err != nil {
+			return err
+		}
+		if b.ID == "" {
+			b.ID = uuid.NewString()
+		}
+		if err := tx.Create(b).Error; err != nil {
+			return err
+		}
+		if b.PromoCode != "" {
+			if err := reservePromo(tx, b); err != nil {
+				return err
+			}
+		}
+		if err := recordTransition(tx, b.ID, "", b.Status, b.UserID, "created"); err != nil {
+			return err
+		}
+		return enqueue(tx, emit, b)
+	})
+}
+
+// CreateSeries สร้าง series + booking ลูกใน txn เดียว โดยใช้ lockOverlap แบบเดียวกับ CreateWithNoOverlap
+// คืน booking ที่ชนกับของเดิม; ถ้า skipConflicts=false และมีตัวชน จะ rollback ทั้งหมดและคืน ErrOverlap
+func (r *BookingRepo) CreateSeries(ctx context.Context, series *domain.BookingSeries, children []*domain.Booking, skipConflicts bool, emit Emit) (created, conflicts []*domain.Booking, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, conflicts = nil, nil
+		if series.ID == "" {
+			series.ID = uuid.NewString()
+		}
+		if err := tx.Create(series).Error; err != nil {
+			return err
+		}
+		for _, b := range children {
+			err := lockOverlap(tx, b)
+			if errors.Is(err, ErrOverlap) {
+				conflicts = append(conflicts, b)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if len(conflicts) > 0 && !skipConflicts {
+				continue // จะ rollback อยู่แล้ว แค่เก็บรายการชนให้ครบ
+			}
+			if b.ID == "" {
+				b.ID = uuid.NewString()
+			}
+			b.SeriesID = series.ID
+			if err := tx.Create(b).Error; err != nil {
+				return err
+			}
+			if err := recordTransition(tx, b.ID, "", b.Status, b.UserID, "created by series "+series.ID); err != nil {
+				return err
+			}
+			if err := enqueue(tx, emit, b); err != nil {
+				return err
+			}
+			created = append(created, b)
+		}
+		if len(conflicts) > 0 && !skipConflicts {
+			created = nil
 			return ErrOverlap
 		}
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		if len(created) == 0 {
+			return ErrOverlap
+		}
+		return nil
+	})
+	return created, conflicts, err
+}
+
+// SeriesByID คืน series
+func (r *BookingRepo) SeriesByID(ctx context.Context, id string) (*domain.BookingSeries, error) {
+	var s domain.BookingSeries
+	if err := r.db.WithContext(ctx).First(&s, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// CancelSeries ยกเลิก series และ booking ลูกที่ยังไม่เริ่ม (PENDING/CONFIRMED) ผ่าน state machine เดียวกับ Transition คืนรายการที่ถูกยกเลิก
+// PENDING ที่ hold หมดแล้วไม่ถูกยกเลิก (sweeper เปลี่ยนเป็น EXPIRED)
+func (r *BookingRepo) CancelSeries(ctx context.Context, seriesID string, now time.Time, actor, reason string, emit Emit) ([]domain.Booking, error) {
+	var out []domain.Booking
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var s domain.BookingSeries
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&s, "id = ?", seriesID).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("series_id = ? AND status IN ? AND start_time > ?", seriesID, []string{domain.StatusPending, domain.StatusConfirmed}, now).
+			Order("start_time ASC").
+			Find(&out).Error; err != nil {
 			return err
 		}
+		cancelled := out[:0]
+		for i := range out {
+			err := transition(tx, &out[i], domain.StatusCancelled, actor, reason, now, emit)
+			if errors.Is(err, domain.ErrIllegalTransition) {
+				continue // PENDING ที่ hold หมดแล้ว: ปล่อยให้ sweeper เปลี่ยนเป็น EXPIRED (booking.expired)
+			}
+			if err != nil {
+				return err
+			}
+			cancelled = append(cancelled, out[i])
+		}
+		out = cancelled
+		s.Status = domain.SeriesCancelled
+		return tx.Save(&s).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-		if b.ID == "" {
-			b.ID = uuid.NewString()
+// Busy คืน booking ที่ยังครองช่องเวลาของสนามในช่วง [from, to) เรียงตามเวลาเริ่ม
+func (r *BookingRepo) Busy(ctx context.Context, courtID string, from, to time.Time) ([]domain.Booking, error) {
+	var out []domain.Booking
+	err := r.db.WithContext(ctx).
+		Where("court_id = ?", courtID).
+		Scopes(holdsSlot(time.Now().UTC())).
+		Where("start_time < ? AND end_time > ?", to, from).
+		Order("start_time ASC").
+		Find(&out).Error
+	return out, err
+}
+
+// Reschedule ล็อก booking แล้วให้ apply แก้เวลา/สนาม จากนั้นเช็คช่องชนภายใต้ lock เดียวกับ CreateWithNoOverlap
+// ทั้งหมดอยู่ใน txn เดียว จึงไม่มีช่วงที่ booking ปล่อยช่องเดิมก่อนได้ช่องใหม่; คืนค่าก่อนแก้ (old) และหลังแก้
+func (r *BookingRepo) Reschedule(ctx context.Context, id string, apply func(b *domain.Booking) error, emit Emit) (old, b *domain.Booking, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cur domain.Booking
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&cur, "id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := loadShares(tx, &cur); err != nil { // booking หารจ่ายย้ายได้เฉพาะราคาเดิม (service ตรวจ)
+			return err
 		}
-		return tx.Create(b).Error
+		prev := cur
+		if err := apply(&cur); err != nil {
+			return err
+		}
+		if err := lockOverlap(tx, &cur); err != nil {
+			return err
+		}
+		if err := tx.Save(&cur).Error; err != nil {
+			return err
+		}
+		if cur.PromoCode != "" && cur.Discount != prev.Discount {
+			if err := tx.Model(&domain.PromoRedemption{}).Where("booking_id = ?", cur.ID).Update("discount", cur.Discount).Error; err != nil {
+				return err
+			}
+		}
+		if err := enqueue(tx, emit, &cur); err != nil {
+			return err
+		}
+		old, b = &prev, &cur
+		return nil
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return old, b, nil
 }
 
 func (r *BookingRepo) ByID(ctx context.Context, id string) (*domain.Booking, error) {
@@ -56,23 +259,175 @@ func (r *BookingRepo) ByID(ctx context.Context, id string) (*domain.Booking, err
 	return &b, nil
 }
 
-func (r *BookingRepo) UpdateStatus(ctx context.Context, id, to string) (*domain.Booking, error) {
+// Transition เปลี่ยนสถานะตาม state machine (domain.CheckTransition) พร้อมบันทึก history ใน txn เดียว
+func (r *BookingRepo) Transition(ctx context.Context, id, to, actor, reason string, emit Emit) (*domain.Booking, error) {
 	var b domain.Booking
-	tx := r.db.WithContext(ctx).Begin()
-	if err := tx.First(&b, "id = ?", id).Error; err != nil {
-		tx.Rollback()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&b, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return transition(tx, &b, to, actor, reason, time.Now().UTC(), emit)
+	})
+	if err != nil {
 		return nil, err
 	}
+	return &b, nil
+}
+
+// transition เปลี่ยนสถานะของ b (ล็อกไว้แล้ว) ผ่าน state machine พร้อมประวัติ, โค้ดส่วนลด, ส่วนแบ่ง และ event ใน tx
+func transition(tx *gorm.DB, b *domain.Booking, to, actor, reason string, now time.Time, emit Emit) error {
+	from := b.Status
+	if err := domain.CheckBookingTransition(b, to, now); err != nil {
+		return err
+	}
 	b.Status = to
-	if err := tx.Save(&b).Error; err != nil {
-		tx.Rollback()
+	if err := tx.Save(b).Error; err != nil {
+		return err
+	}
+	if err := recordTransition(tx, b.ID, from, to, actor, reason); err != nil {
+		return err
+	}
+	if b.PromoCode != "" {
+		if err := settlePromo(tx, to, b.ID); err != nil {
+			return err
+		}
+	}
+	// ส่วนแบ่งที่จ่ายแล้วต้องอยู่ใน event (คืนเงินแยกตาม charge)
+	if err := settleShares(tx, to, b.ID); err != nil {
+		return err
+	}
+	if err := loadShares(tx, b); err != nil {
+		return err
+	}
+	return enqueue(tx, emit, b)
+}
+
+// History ประวัติการเปลี่ยนสถานะของ booking เรียงตามเวลา
+func (r *BookingRepo) History(ctx context.Context, bookingID string) ([]domain.BookingStatusHistory, error) {
+	var out []domain.BookingStatusHistory
+	err := r.db.WithContext(ctx).Where("booking_id = ?", bookingID).Order("created_at ASC, id ASC").Find(&out).Error
+	return out, err
+}
+
+// ExpireHolds ย้าย PENDING ที่ hold หมดอายุแล้ว (expires_at <= now) ไปเป็น EXPIRED ทีละไม่เกิน limit แถว
+// ใช้ SKIP LOCKED เพื่อไม่ชนกับ txn ที่กำลัง confirm booking เดียวกันอยู่
+func (r *BookingRepo) ExpireHolds(ctx context.Context, now time.Time, limit int, emit Emit) ([]domain.Booking, error) {
+	var out []domain.Booking
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND expires_at <= ?", domain.StatusPending, now).
+			Order("expires_at ASC").
+			Limit(limit).
+			Find(&out).Error; err != nil {
+			return err
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		ids := make([]string, len(out))
+		hist := make([]domain.BookingStatusHistory, len(out))
+		var promoIDs []string
+		for i := range out {
+			ids[i] = out[i].ID
+			if out[i].PromoCode != "" {
+				promoIDs = append(promoIDs, out[i].ID)
+			}
+			hist[i] = domain.BookingStatusHistory{
+				BookingID: out[i].ID, FromStatus: out[i].Status, ToStatus: domain.StatusExpired,
+				Actor: "system:sweeper", Reason: "hold expired",
+			}
+			out[i].Status = domain.StatusExpired
+		}
+		if err := tx.Model(&domain.Booking{}).Where("id IN ?", ids).Update("status", domain.StatusExpired).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&hist).Error; err != nil {
+			return err
+		}
+		if err := settlePromo(tx, domain.StatusExpired, promoIDs...); err != nil {
+			return err
+		}
+		if err := settleShares(tx, domain.StatusExpired, ids...); err != nil {
+			return err
+		}
+		ptrs := make([]*domain.Booking, len(out))
+		for i := range out {
+			ptrs[i] = &out[i]
+		}
+		if err := loadShares(tx, ptrs...); err != nil {
+			return err
+		}
+		for i := range out {
+			if err := enqueue(tx, emit, &out[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &b, tx.Commit().Error
+	return out, nil
+}
+
+// RecordPaymentFailure นับความล้มเหลวของการชำระเงิน (ใช้ตอน consume payment.failed)
+// กันซ้ำด้วย eventID (ต่อ charge); booking ที่ไม่ใช่ PENDING จะไม่ถูกนับ
+// cancel ตัดสินจาก booking หลังนับแล้ว ถ้า true จะเปลี่ยนเป็น CANCELLED ใน txn เดียวกัน
+func (r *BookingRepo) RecordPaymentFailure(ctx context.Context, bookingID, eventID, code, reason string, cancel func(*domain.Booking) bool, emit Emit) (b *domain.Booking, cancelled bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var exists int64
+		if err := tx.Model(&domain.EventConsumed{}).Where("id = ?", eventID).Count(&exists).Error; err != nil {
+			return err
+		}
+		if exists > 0 {
+			b = nil
+			return nil
+		}
+		if err := tx.Create(&domain.EventConsumed{ID: eventID, EventKey: "payment.failed", ProcessedAt: time.Now().UTC()}).Error; err != nil {
+			return err
+		}
+		var cur domain.Booking
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&cur, "id = ?", bookingID).Error; err != nil {
+			return err
+		}
+		b = &cur
+		if cur.Status != domain.StatusPending {
+			return nil
+		}
+		cur.PaymentFailures++
+		cur.LastPaymentError = code
+		if cancelled = cancel(&cur); cancelled {
+			cur.Status = domain.StatusCancelled
+		}
+		if err := tx.Save(&cur).Error; err != nil {
+			return err
+		}
+		if cancelled {
+			if err := recordTransition(tx, cur.ID, domain.StatusPending, domain.StatusCancelled, "system:payment", reason); err != nil {
+				return err
+			}
+			if cur.PromoCode != "" {
+				if err := settlePromo(tx, domain.StatusCancelled, cur.ID); err != nil {
+					return err
+				}
+			}
+			if err := settleShares(tx, domain.StatusCancelled, cur.ID); err != nil {
+				return err
+			}
+			if err := loadShares(tx, &cur); err != nil {
+				return err
+			}
+			return enqueue(tx, emit, &cur)
+		}
+		return nil
+	})
+	return b, cancelled, err
 }
 
 // IdempotentConfirm: ใช้ตอน consume payment.paid
-func (r *BookingRepo) ConfirmIfNotProcessed(ctx context.Context, bookingID, eventID, eventKey string) (*domain.Booking, error) {
+// ถ้า booking อยู่ในสถานะที่ confirm ไม่ได้แล้ว (เช่น CANCELLED/EXPIRED หรือ PENDING ที่ hold หมดแล้ว) จะบันทึก event ว่ากินแล้ว
+// พร้อม event จาก reject (คืนเงิน) ใน txn เดียวกัน แล้วคืน booking พร้อม error ที่ห่อ domain.ErrIllegalTransition
+func (r *BookingRepo) ConfirmIfNotProcessed(ctx context.Context, bookingID, eventID, eventKey string, emit Emit, reject Reject) (*domain.Booking, error) {
 	var b domain.Booking
 	tx := r.db.WithContext(ctx).Begin()
 
@@ -92,15 +447,53 @@ func (r *BookingRepo) ConfirmIfNotProcessed(ctx context.Context, bookingID, even
 		return &b, nil
 	}
 
-	// 2) อัปเดต booking -> CONFIRMED
-	if err := tx.First(&b, "id = ?", bookingID).Error; err != nil {
+	// 2) อัปเดต booking -> CONFIRMED (ล็อกแถวไว้กัน sweeper/ยกเลิกแทรกระหว่างตรวจ)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&b, "id = ?", bookingID).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
-	// ถ้า Cancelled ไปแล้ว อาจเลือกข้าม (business rule)
-	if b.Status != "CONFIRMED" {
-		b.Status = "CONFIRMED"
-		if err := tx.Save(&b).Error; err != nil {
+	var illegal error
+	if b.Status == domain.StatusConfirmed && eventKey == events.RKPaymentPaid && b.PaymentID != eventID {
+		// charge ที่สองของ booking ที่จ่ายแล้ว (เช่น สแกน QR สองใบ): ไม่ต้องใช้เงินนี้ ให้ payment-service คืน
+		illegal = fmt.Errorf("%w: booking is already paid by %q", domain.ErrIllegalTransition, b.PaymentID)
+	}
+	if b.Status != domain.StatusConfirmed {
+		// hold หมดแล้ว = ช่องอาจถูกคนอื่นจองไปแล้ว: ไม่ confirm แม้ยังเป็น PENDING
+		if illegal = domain.CheckBookingTransition(&b, domain.StatusConfirmed, time.Now().UTC()); illegal == nil {
+			from := b.Status
+			b.Status = domain.StatusConfirmed
+			if eventKey == events.RKPaymentPaid {
+				b.PaymentID = eventID // payment.paid ใช้ charge id เป็น eventID
+			}
+			if err := tx.Save(&b).Error; err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if err := recordTransition(tx, b.ID, from, b.Status, "system:payment", eventKey+" "+eventID); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			// โค้ดส่วนลดถูกนับว่าใช้แล้วพร้อมกับการชำระเงินสำเร็จ
+			if b.PromoCode != "" {
+				if err := settlePromo(tx, b.Status, b.ID); err != nil {
+					tx.Rollback()
+					return nil, err
+				}
+			}
+			// จ่ายเต็มก้อนทั้ง booking: ส่วนแบ่งที่ยังไม่จ่ายไม่ต้องจ่ายแล้ว
+			if err := settleShares(tx, b.Status, b.ID); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if err := enqueue(tx, emit, &b); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+	}
+
+	if illegal != nil && reject != nil {
+		if err := outbox.Enqueue(tx, reject(&b, illegal)...); err != nil {
 			tx.Rollback()
 			return nil, err
 		}
@@ -113,10 +506,23 @@ func (r *BookingRepo) ConfirmIfNotProcessed(ctx context.Context, bookingID, even
 		return nil, err
 	}
 
-	return &b, tx.Commit().Error
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return &b, illegal
+}
+
+// ListFilter เงื่อนไขของ List (ค่าว่าง = ไม่กรอง)
+type ListFilter struct {
+	UserID  string
+	CourtID string
+	DayISO  string
+	// VisibleTo จำกัดผลเป็น booking ของ user นี้ หรือบนสนามใน VisibleCourts (ใช้กับ OWNER)
+	VisibleTo     string
+	VisibleCourts []string
 }
 
-func (r *BookingRepo) List(ctx context.Context, page, size int32, userID, courtID, dayISO string) ([]domain.Booking, int64, error) {
+func (r *BookingRepo) List(ctx context.Context, page, size int32, f ListFilter) ([]domain.Booking, int64, error) {
 	if size <= 0 {
 		size = 20
 	}
@@ -124,14 +530,21 @@ func (r *BookingRepo) List(ctx context.Context, page, size int32, userID, courtI
 		page = 0
 	}
 	qb := r.db.WithContext(ctx).Model(&domain.Booking{})
-	if userID != "" {
-		qb = qb.Where("user_id = ?", userID)
+	if f.UserID != "" {
+		qb = qb.Where("user_id = ?", f.UserID)
 	}
-	if courtID != "" {
-		qb = qb.Where("court_id = ?", courtID)
+	if f.CourtID != "" {
+		qb = qb.Where("court_id = ?", f.CourtID)
+	}
+	if f.VisibleTo != "" {
+		if len(f.VisibleCourts) > 0 {
+			qb = qb.Where("(user_id = ? OR court_id IN ?)", f.VisibleTo, f.VisibleCourts)
+		} else {
+			qb = qb.Where("user_id = ?", f.VisibleTo)
+		}
 	}
-	if dayISO != "" {
-		if d, err := time.Parse(time.RFC3339, dayISO); err == nil {
+	if f.DayISO != "" {
+		if d, err := time.Parse(time.RFC3339, f.DayISO); err == nil {
 			from := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
 			to := from.Add(24 * time.Hour)
 			qb = qb.Where("start_time < ? AND end_time > ?", to, from) // any overlap with that day
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+var (
+	ErrPromoExists = errors.New("promo_code_exists")
+	// ErrPromoUnavailable โค้ดถูกปิด หรือสิทธิ์ใช้ (ทั้งหมด/ต่อคน) เต็มแล้ว ตอนจองจริง
+	ErrPromoUnavailable = errors.New("promo_code_unavailable")
+)
+
+// usedStatuses การใช้ที่นับรวมใน limit
+var usedStatuses = []string{domain.RedemptionReserved, domain.RedemptionRedeemed}
+
+// reservePromo กันสิทธิ์ใช้โค้ดให้ b (เรียกใน txn ที่สร้าง booking)
+// ล็อกแถว promotion ก่อนนับ ทำให้ booking ที่ใช้โค้ดเดียวกันพร้อมกันต้องรอคิว จึงใช้เกิน limit ไม่ได้
+func reservePromo(tx *gorm.DB, b *domain.Booking) error {
+	var p domain.Promotion
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&p, "code = ?", b.PromoCode).Error; err != nil {
+		return err
+	}
+	if !p.Active {
+		return fmt.Errorf("%w: %s is no longer active", ErrPromoUnavailable, p.Code)
+	}
+	if p.UsageLimit > 0 {
+		var used int64
+		if err := tx.Model(&domain.PromoRedemption{}).Where("code = ? AND status IN ?", p.Code, usedStatuses).Count(&used).Error; err != nil {
+			return err
+		}
+		if used >= int64(p.UsageLimit) {
+			return fmt.Errorf("%w: %s has been fully redeemed", ErrPromoUnavailable, p.Code)
+		}
+	}
+	if p.PerUserLimit > 0 {
+		var mine int64
+		if err := tx.Model(&domain.PromoRedemption{}).Where("code = ? AND user_id = ? AND status IN ?", p.Code, b.UserID, usedStatuses).Count(&mine).Error; err != nil {
+			return err
+		}
+		if mine >= int64(p.PerUserLimit) {
+			return fmt.Errorf("%w: %s can be used %d time(s) per user", ErrPromoUnavailable, p.Code, p.PerUserLimit)
+		}
+	}
+	return tx.Create(&domain.PromoRedemption{
+		BookingID: b.ID, Code: p.Code, UserID: b.UserID, Discount: b.Discount, Status: domain.RedemptionReserved,
+	}).Error
+}
+
+// settlePromo ปรับการใช้โค้ดตามสถานะใหม่ของ booking (ใน txn เดียวกับที่เปลี่ยนสถานะ)
+// CONFIRMED = ใช้จริง, CANCELLED/EXPIRED ก่อนจ่าย = คืนสิทธิ์; ที่ REDEEMED แล้วไม่เปลี่ยน
+func settlePromo(tx *gorm.DB, bookingStatus string, bookingIDs ...string) error {
+	var to string
+	switch bookingStatus {
+	case domain.StatusConfirmed:
+		to = domain.RedemptionRedeemed
+	case domain.StatusCancelled, domain.StatusExpired:
+		to = domain.RedemptionReleased
+	default:
+		return nil
+	}
+	if len(bookingIDs) == 0 {
+		return nil
+	}
+	return tx.Model(&domain.PromoRedemption{}).
+		Where("booking_id IN ? AND status = ?", bookingIDs, domain.RedemptionReserved).
+		Update("status", to).Error
+}
+
+func (r *BookingRepo) CreatePromotion(ctx context.Context, p *domain.Promotion) error {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(p)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrPromoExists, p.Code)
+	}
+	return nil
+}
+
+func (r *BookingRepo) Promotion(ctx context.Context, code string) (*domain.Promotion, error) {
+	var p domain.Promotion
+	if err := r.db.WithContext(ctx).First(&p, "code = ?", code).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *BookingRepo) ListPromotions(ctx context.Context, page, size int32, activeOnly bool) ([]domain.Promotion, int64, error) {
+	if size <= 0 {
+		size = 20
+	}
+	if page < 0 {
+		page = 0
+	}
+	qb := r.db.WithContext(ctx).Model(&domain.Promotion{})
+	if activeOnly {
+		qb = qb.Where("active = ?", true)
+	}
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var out []domain.Promotion
+	if err := qb.Order("created_at DESC").Limit(int(size)).Offset(int(page * size)).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+// SetPromotionActive เปิด/ปิดโค้ด (booking ที่กันสิทธิ์ไว้แล้วยังใช้ต่อได้)
+func (r *BookingRepo) SetPromotionActive(ctx context.Context, code string, active bool) (*domain.Promotion, error) {
+	res := r.db.WithContext(ctx).Model(&domain.Promotion{}).Where("code = ?", code).Update("active", active)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.Promotion(ctx, code)
+}
+
+// PromoUsage จำนวนการใช้ (RESERVED + REDEEMED) ต่อโค้ด
+func (r *BookingRepo) PromoUsage(ctx context.Context, codes []string) (map[string]int64, error) {
+	out := make(map[string]int64, len(codes))
+	if len(codes) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		Code string
+		N    int64
+	}
+	err := r.db.WithContext(ctx).Model(&domain.PromoRedemption{}).
+		Select("code, COUNT(*) AS n").
+		Where("code IN ? AND status IN ?", codes, usedStatuses).
+		Group("code").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.Code] = row.N
+	}
+	return out, nil
+}
+
+// UserPromoUses จำนวนครั้งที่ userID ใช้โค้ดนี้ (RESERVED + REDEEMED)
+func (r *BookingRepo) UserPromoUses(ctx context.Context, code, userID string) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&domain.PromoRedemption{}).
+		Where("code = ? AND user_id = ? AND status IN ?", code, userID, usedStatuses).Count(&n).Error
+	return n, err
+}
+
+// HasPaidBooking userID เคยมี booking ที่ชำระ/ยืนยันแล้วหรือยัง (ใช้กับโค้ดสมาชิกใหม่)
+func (r *BookingRepo) HasPaidBooking(ctx context.Context, userID string) (bool, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&domain.Booking{}).
+		Where("user_id = ? AND (payment_id <> '' OR status IN ?)", userID,
+			[]string{domain.StatusConfirmed, domain.StatusCompleted, domain.StatusNoShow}).
+		Limit(1).Count(&n).Error
+	return n > 0, err
+}
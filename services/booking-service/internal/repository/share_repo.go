@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// loadShares เติม Shares ให้ bookings (ใช้ tx เดียวกับที่ล็อก booking ไว้ จึงเห็นสถานะล่าสุด)
+func loadShares(tx *gorm.DB, bs ...*domain.Booking) error {
+	if len(bs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(bs))
+	byID := make(map[string]*domain.Booking, len(bs))
+	for i, b := range bs {
+		ids[i] = b.ID
+		byID[b.ID] = b
+		b.Shares = nil
+	}
+	var shares []domain.PaymentShare
+	if err := tx.Where("booking_id IN ?", ids).Order("created_at ASC, id ASC").Find(&shares).Error; err != nil {
+		return err
+	}
+	for _, sh := range shares {
+		b := byID[sh.BookingID]
+		b.Shares = append(b.Shares, sh)
+	}
+	return nil
+}
+
+// settleShares booking ที่ไม่รอเงินแล้ว (CONFIRMED ด้วยทางอื่น/CANCELLED/EXPIRED) ยกเลิกส่วนที่ยังไม่จ่าย
+// ส่วนที่จ่ายแล้วคงไว้ (payment-service คืนเงินตาม event)
+func settleShares(tx *gorm.DB, bookingStatus string, bookingIDs ...string) error {
+	if bookingStatus == domain.StatusPending || len(bookingIDs) == 0 {
+		return nil
+	}
+	return tx.Model(&domain.PaymentShare{}).
+		Where("booking_id IN ? AND status = ?", bookingIDs, domain.SharePending).
+		Update("status", domain.ShareCancelled).Error
+}
+
+// WithShares โหลด Shares ของ b
+func (r *BookingRepo) WithShares(ctx context.Context, b *domain.Booking) error {
+	return loadShares(r.db.WithContext(ctx), b)
+}
+
+// ReplaceShares ล็อก booking แล้วให้ build สร้างชุดส่วนแบ่งใหม่จาก b (Shares ปัจจุบันโหลดไว้ให้; build เปลี่ยน b.ExpiresAt ได้)
+// ส่วนที่ยังไม่จ่ายของเดิมถูกยกเลิก ส่วนที่จ่ายแล้วคงอยู่; ใช้ทั้งตอนแบ่งและตอนผู้จัดจ่ายส่วนที่เหลือแทน
+func (r *BookingRepo) ReplaceShares(ctx context.Context, bookingID string, build func(b *domain.Booking) ([]domain.PaymentShare, error), emit Emit) (*domain.Booking, error) {
+	var b domain.Booking
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&b, "id = ?", bookingID).Error; err != nil {
+			return err
+		}
+		if err := loadShares(tx, &b); err != nil {
+			return err
+		}
+		exp := b.ExpiresAt
+		shares, err := build(&b)
+		if err != nil {
+			return err
+		}
+		if b.ExpiresAt != exp { // build ขยาย hold ให้มีเวลาจ่าย
+			if err := tx.Model(&domain.Booking{}).Where("id = ?", b.ID).Update("expires_at", b.ExpiresAt).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&domain.PaymentShare{}).
+			Where("booking_id = ? AND status = ?", b.ID, domain.SharePending).
+			Update("status", domain.ShareCancelled).Error; err != nil {
+			return err
+		}
+		for i := range shares {
+			shares[i].ID = uuid.NewString()
+			shares[i].BookingID = b.ID
+			shares[i].Status = domain.SharePending
+		}
+		if len(shares) > 0 {
+			if err := tx.Create(&shares).Error; err != nil {
+				return err
+			}
+		}
+		if err := loadShares(tx, &b); err != nil {
+			return err
+		}
+		return enqueue(tx, emit, &b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// PayShare บันทึกว่าส่วน shareID จ่ายแล้วด้วย paymentID (ใช้ตอน consume payment.paid ที่มี share_id)
+// ครบทุกส่วน = CONFIRMED ใน txn เดียวกัน; idempotent ด้วย paymentID เหมือน ConfirmIfNotProcessed
+// booking/ส่วนที่ไม่รอเงินแล้ว (หรือไม่มีส่วนนี้) เขียน event จาก reject (คืนเงิน) ใน txn เดียวกัน แล้วคืน error ที่ห่อ domain.ErrIllegalTransition
+func (r *BookingRepo) PayShare(ctx context.Context, bookingID, shareID, paymentID string, emit Emit, reject Reject) (*domain.Booking, error) {
+	var b domain.Booking
+	var illegal error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var exists int64
+		if err := tx.Model(&domain.EventConsumed{}).Where("id = ?", paymentID).Count(&exists).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&b, "id = ?", bookingID).Error; err != nil {
+			return err
+		}
+		if exists > 0 {
+			return loadShares(tx, &b)
+		}
+		if err := tx.Create(&domain.EventConsumed{ID: paymentID, EventKey: events.RKPaymentPaid, ProcessedAt: time.Now().UTC()}).Error; err != nil {
+			return err
+		}
+
+		var sh domain.PaymentShare
+		err := tx.First(&sh, "id = ? AND booking_id = ?", shareID, bookingID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		switch {
+		case err != nil:
+			illegal = fmt.Errorf("%w: share %s not found", domain.ErrIllegalTransition, shareID)
+		case sh.Status != domain.SharePending:
+			illegal = fmt.Errorf("%w: share %s is %s", domain.ErrIllegalTransition, sh.ID, sh.Status)
+		case b.Status != domain.StatusPending:
+			illegal = fmt.Errorf("%w: share of a %s booking", domain.ErrIllegalTransition, b.Status)
+		case b.HoldExpired(time.Now().UTC()):
+			illegal = fmt.Errorf("%w: share paid after the hold expired", domain.ErrIllegalTransition)
+		}
+		if illegal != nil {
+			// ส่วนถูกแบ่งใหม่/ยกเลิกระหว่างจ่าย, booking ไม่รอเงินแล้ว: คืนเงินก้อนนี้
+			if reject != nil {
+				if err := outbox.Enqueue(tx, reject(&b, illegal)...); err != nil {
+					return err
+				}
+			}
+			return loadShares(tx, &b)
+		}
+
+		now := time.Now().UTC()
+		sh.Status, sh.PaymentID, sh.PaidAt = domain.SharePaid, paymentID, &now
+		if err := tx.Save(&sh).Error; err != nil {
+			return err
+		}
+		if err := loadShares(tx, &b); err != nil {
+			return err
+		}
+		for _, s := range b.Shares {
+			if s.Status == domain.SharePending {
+				return nil // ยังรอคนอื่น
+			}
+		}
+
+		b.Status, b.PaymentID = domain.StatusConfirmed, paymentID // charge สุดท้ายที่ทำให้ครบ
+		if err := tx.Save(&b).Error; err != nil {
+			return err
+		}
+		if err := recordTransition(tx, b.ID, domain.StatusPending, b.Status, "system:payment", "all shares paid"); err != nil {
+			return err
+		}
+		if b.PromoCode != "" {
+			if err := settlePromo(tx, b.Status, b.ID); err != nil {
+				return err
+			}
+		}
+		return enqueue(tx, emit, &b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &b, illegal
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// ErrPermissionDenied ผู้เรียกไม่มีสิทธิ์กับ booking นี้ (codes.PermissionDenied)
+var ErrPermissionDenied = errors.New("permission_denied")
+
+const (
+	RoleUser  = "USER"
+	RoleOwner = "OWNER"
+	RoleAdmin = "ADMIN"
+)
+
+// Actor ตัวตนของผู้เรียก (จาก gRPC metadata ที่ gateway แนบมา)
+// ไม่มี Actor ใน ctx = เรียกจากภายใน (consumer, sweeper, service อื่นในเครือข่าย) ไม่ถูกจำกัดสิทธิ์
+type Actor struct {
+	UserID string
+	Role   string
+	Email  string // ใช้จับคู่กับคำเชิญหารจ่ายที่เชิญด้วย email
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+func ActorFrom(ctx context.Context) (Actor, bool) {
+	a, ok := ctx.Value(actorKey{}).(Actor)
+	return a, ok
+}
+
+// actorName ชื่อผู้กระทำสำหรับ status history: user id ของผู้เรียก หรือ fallback (system:<component>)
+func actorName(ctx context.Context, fallback string) string {
+	if a, ok := ActorFrom(ctx); ok && a.UserID != "" {
+		return a.UserID
+	}
+	return fallback
+}
+
+// ownsCourt เช็คว่า ownerID เป็นเจ้าของสนาม courtID
+func (s *BookingSvc) ownsCourt(ctx context.Context, courtID, ownerID string) (bool, error) {
+	res, err := s.court.GetCourt(ctx, &courtv1.GetCourtRequest{Id: courtID})
+	if err != nil {
+		return false, err
+	}
+	return res.GetCourt().GetOwnerId() == ownerID, nil
+}
+
+// ownedCourtIDs รายการสนามทั้งหมดของ owner (ไล่ทีละหน้า)
+func (s *BookingSvc) ownedCourtIDs(ctx context.Context, ownerID string) ([]string, error) {
+	const size = 100
+	var ids []string
+	for page := int32(0); ; page++ {
+		res, err := s.court.ListCourts(ctx, &courtv1.ListCourtsRequest{Page: page, PageSize: size, OwnerId: ownerID})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.Courts {
+			ids = append(ids, c.Id)
+		}
+		if len(res.Courts) < size {
+			return ids, nil
+		}
+	}
+}
+
+// authorize กฎสิทธิ์ต่อ booking หนึ่งรายการ:
+// USER เฉพาะของตัวเอง, OWNER ของตัวเองหรือบนสนามที่ตัวเองเป็นเจ้าของ, ADMIN ทั้งหมด
+func (s *BookingSvc) authorize(ctx context.Context, b *domain.Booking) error {
+	a, ok := ActorFrom(ctx)
+	if !ok || a.Role == RoleAdmin {
+		return nil
+	}
+	if a.UserID != "" && b.UserID == a.UserID {
+		return nil
+	}
+	if a.Role == RoleOwner && a.UserID != "" {
+		owns, err := s.ownsCourt(ctx, b.CourtID, a.UserID)
+		if err != nil {
+			return err
+		}
+		if owns {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: booking %s", ErrPermissionDenied, b.ID)
+}
+
+// authorizeView สิทธิ์ดู booking: ตาม authorize หรือเป็นผู้ถูกเชิญให้หารจ่าย (ต้องโหลด Shares มาก่อน)
+func (s *BookingSvc) authorizeView(ctx context.Context, b *domain.Booking) error {
+	err := s.authorize(ctx, b)
+	if err == nil || !errors.Is(err, ErrPermissionDenied) {
+		return err
+	}
+	if a, _ := ActorFrom(ctx); isParticipant(a, b) {
+		return nil
+	}
+	return err
+}
+
+// isParticipant a ถูกเชิญให้จ่ายส่วนหนึ่งของ b (ด้วย user id หรือ email)
+func isParticipant(a Actor, b *domain.Booking) bool {
+	for _, sh := range b.Shares {
+		if (a.UserID != "" && sh.UserID == a.UserID) || (a.Email != "" && strings.EqualFold(sh.Email, a.Email)) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeOwnerAction สำหรับ action ฝั่งสนาม (เช่น confirm ด้วยมือ): เฉพาะ ADMIN หรือเจ้าของสนาม
+func (s *BookingSvc) authorizeOwnerAction(ctx context.Context, b *domain.Booking) error {
+	a, ok := ActorFrom(ctx)
+	if !ok || a.Role == RoleAdmin {
+		return nil
+	}
+	if a.Role == RoleOwner && a.UserID != "" {
+		owns, err := s.ownsCourt(ctx, b.CourtID, a.UserID)
+		if err != nil {
+			return err
+		}
+		if owns {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: only the court owner or an admin can do this", ErrPermissionDenied)
+}
+
+// bookerFor คืน user_id ที่จะใช้สร้าง booking: USER/OWNER จองได้ในนามตัวเองเท่านั้น
+func bookerFor(ctx context.Context, userID string) (string, error) {
+	a, ok := ActorFrom(ctx)
+	if !ok || a.Role == RoleAdmin {
+		if userID == "" && ok {
+			return a.UserID, nil
+		}
+		return userID, nil
+	}
+	if userID != "" && userID != a.UserID {
+		return "", fmt.Errorf("%w: cannot book on behalf of another user", ErrPermissionDenied)
+	}
+	return a.UserID, nil
+}
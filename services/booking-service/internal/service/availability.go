@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// Availability ผลลัพธ์ของ GetAvailability: เวลาเปิด-ปิดของสนาม + ช่องว่าง/ไม่ว่างเรียงตามเวลา
+type Availability struct {
+	CourtID  string
+	Date     string // YYYY-MM-DD
+	OpenFrom string // HH:mm
+	OpenTo   string // HH:mm
+	Slots    []domain.Slot
+}
+
+// parseHHMM แปลง "HH:mm" เป็นระยะเวลานับจากเที่ยงคืน
+func parseHHMM(s string) (time.Duration, error) {
+	hh, mm, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || h < 0 || h > 24 {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// openingWindow คืนช่วงเวลาเปิดสนามของวันที่ day (เที่ยงคืนตามเวลาท้องถิ่นของสนาม)
+// ถ้า open_to <= open_from ถือว่าปิดหลังเที่ยงคืน (เช่น 18:00-02:00)
+func openingWindow(c *courtv1.Court, day time.Time) (time.Time, time.Time, error) {
+	from, err := parseHHMM(c.OpenFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseHHMM(c.OpenTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if to <= from {
+		to += 24 * time.Hour
+	}
+	return day.Add(from), day.Add(to), nil
+}
+
+// Availability รวมเวลาเปิด-ปิดจาก court-service กับ booking ที่ยัง PENDING/CONFIRMED
+func (s *BookingSvc) Availability(ctx context.Context, courtID, date string) (*Availability, error) {
+	if courtID == "" {
+		return nil, fmt.Errorf("%w: court_id is required", ErrInvalidArgument)
+	}
+	day, err := time.ParseInLocation(time.DateOnly, date, s.opts.Location)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+
+	res, err := s.court.GetCourt(ctx, &courtv1.GetCourtRequest{Id: courtID})
+	if err != nil {
+		return nil, err
+	}
+	court := res.GetCourt()
+	open, closeAt, err := openingWindow(court, day)
+	if err != nil {
+		return nil, fmt.Errorf("court opening hours: %w", err)
+	}
+
+	busy, err := s.repo.Busy(ctx, courtID, open, closeAt)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Availability{CourtID: courtID, Date: date, OpenFrom: court.OpenFrom, OpenTo: court.OpenTo}
+	cur := open.UTC()
+	end := closeAt.UTC()
+	for _, b := range busy {
+		st, et := b.StartTime.UTC(), b.EndTime.UTC()
+		if st.Before(cur) {
+			st = cur
+		}
+		if et.After(end) {
+			et = end
+		}
+		if !et.After(cur) {
+			continue
+		}
+		if st.After(cur) {
+			out.Slots = append(out.Slots, domain.Slot{Start: cur, End: st, Free: true})
+		}
+		// รวมช่วงที่ไม่ว่างซึ่งต่อเนื่อง/ซ้อนกันให้เป็นช่องเดียว
+		if n := len(out.Slots); n > 0 && !out.Slots[n-1].Free && !out.Slots[n-1].End.Before(st) {
+			out.Slots[n-1].End = et
+		} else {
+			out.Slots = append(out.Slots, domain.Slot{Start: st, End: et})
+		}
+		cur = et
+	}
+	if cur.Before(end) {
+		out.Slots = append(out.Slots, domain.Slot{Start: cur, End: end, Free: true})
+	}
+	return out, nil
+}
@@ -3,20 +3,80 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
-	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/pkg/events"
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
 	"github.com/you/badminton-booking/services/booking-service/internal/domain"
 	"github.com/you/badminton-booking/services/booking-service/internal/repository"
 )
 
+var (
+	// ErrInvalidArgument ใช้ห่อ error จาก input ที่ไม่ถูกต้อง (transport แปลงเป็น codes.InvalidArgument)
+	ErrInvalidArgument = errors.New("invalid_argument")
+	// ErrFailedPrecondition ใช้ห่อ error เมื่อสถานะของ booking ไม่อนุญาต (codes.FailedPrecondition)
+	ErrFailedPrecondition = errors.New("failed_precondition")
+)
+
+// Options ค่าปรับแต่งของ BookingSvc (มาจาก env ใน cmd/booking)
+type Options struct {
+	// Location เขตเวลาของสนาม ใช้ตีความวันที่และเวลาเปิด-ปิด (HH:mm)
+	Location *time.Location
+	// SlotGranularity เวลาเริ่ม/จบของ booking ต้องลงตัวกับหน่วยนี้ (เช่น 30 นาที)
+	SlotGranularity time.Duration
+	// HoldTTL ระยะเวลาที่ PENDING ครองช่องไว้รอชำระเงิน ก่อนถูก sweeper เปลี่ยนเป็น EXPIRED
+	HoldTTL time.Duration
+	// SplitHoldTTL แบ่งจ่าย/ผู้จัดรับส่วนที่เหลือ ขยาย hold เป็นอย่างน้อยเท่านี้จากตอนนั้น (ไม่เกินเวลาเริ่ม) ให้ผู้ร่วมหารมีเวลาจ่าย
+	SplitHoldTTL time.Duration
+	// SeriesPayLead ครั้งถัดไปของ series (ครั้งที่ 2 เป็นต้นไป) รอจ่ายได้จนถึงก่อนเริ่มเท่านี้ (ดู seriesHold)
+	SeriesPayLead time.Duration
+	// Currency สกุลเงินของราคาที่คำนวณ (เช่น THB)
+	Currency string
+	// MinChargeAmount ยอดต่ำสุดที่ gateway รับชำระ (หน่วยย่อย) ส่วนลดโปรโมชันหักได้ไม่เกินจนต่ำกว่านี้
+	MinChargeAmount int64
+	// MaxPaymentFailures ยกเลิก PENDING อัตโนมัติเมื่อชำระเงินล้มเหลวครบจำนวนนี้
+	MaxPaymentFailures int
+	// TerminalFailureCodes failure code ที่ลองใหม่ไม่มีประโยชน์ (ยกเลิกทันที)
+	TerminalFailureCodes []string
+	// RefundPolicy ยอดคืนเมื่อยกเลิก booking ที่จ่ายแล้ว ตามระยะเวลาก่อนเริ่ม (ดู ParseRefundPolicy)
+	RefundPolicy []RefundTier
+}
+
+// BookingSvc ไม่ publish เอง: booking.* events ถูกเขียนลง outbox ใน txn ของ repository (ดู events.go)
 type BookingSvc struct {
-	repo *repository.BookingRepo
-	pub  *mq.Publisher
+	repo  *repository.BookingRepo
+	court courtv1.CourtServiceClient
+	opts  Options
 }
 
-func NewBookingSvc(r *repository.BookingRepo, pub *mq.Publisher) *BookingSvc {
-	return &BookingSvc{repo: r, pub: pub}
+func NewBookingSvc(r *repository.BookingRepo, court courtv1.CourtServiceClient, opts Options) *BookingSvc {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	if opts.SlotGranularity <= 0 {
+		opts.SlotGranularity = 30 * time.Minute
+	}
+	if opts.HoldTTL <= 0 {
+		opts.HoldTTL = 15 * time.Minute
+	}
+	if opts.SplitHoldTTL <= 0 {
+		opts.SplitHoldTTL = 2 * time.Hour
+	}
+	if opts.SeriesPayLead <= 0 {
+		opts.SeriesPayLead = 24 * time.Hour
+	}
+	if opts.Currency == "" {
+		opts.Currency = "THB"
+	}
+	if opts.MinChargeAmount <= 0 {
+		opts.MinChargeAmount = 2000
+	}
+	if opts.MaxPaymentFailures <= 0 {
+		opts.MaxPaymentFailures = 3
+	}
+	return &BookingSvc{repo: r, court: court, opts: opts}
 }
 
 func parseRFC3339UTC(s string) (time.Time, error) {
@@ -27,52 +87,257 @@ func parseRFC3339UTC(s string) (time.Time, error) {
 	return t.UTC(), nil
 }
 
-func (s *BookingSvc) Create(ctx context.Context, userID, courtID, startISO, endISO string) (*domain.Booking, error) {
-	st, err := parseRFC3339UTC(startISO)
+// validateSlot ตรวจว่าสนามมีอยู่จริง แล้วตรวจช่วงเวลาด้วย checkSlot
+func (s *BookingSvc) validateSlot(ctx context.Context, courtID string, st, et time.Time) (*courtv1.Court, error) {
+	if courtID == "" {
+		return nil, fmt.Errorf("%w: court_id is required", ErrInvalidArgument)
+	}
+	res, err := s.court.GetCourt(ctx, &courtv1.GetCourtRequest{Id: courtID})
 	if err != nil {
 		return nil, err
 	}
-	et, err := parseRFC3339UTC(endISO)
-	if err != nil {
+	court := res.GetCourt()
+	if err := s.checkSlot(court, st, et); err != nil {
 		return nil, err
 	}
+	return court, nil
+}
+
+// checkSlot ช่วงเวลาอยู่ในเวลาเปิด-ปิดของ court และลงตัวกับ SlotGranularity
+func (s *BookingSvc) checkSlot(court *courtv1.Court, st, et time.Time) error {
 	if !et.After(st) {
-		return nil, errors.New("end must be after start")
+		return fmt.Errorf("%w: end must be after start", ErrInvalidArgument)
+	}
+
+	lst := st.In(s.opts.Location)
+	day := time.Date(lst.Year(), lst.Month(), lst.Day(), 0, 0, 0, 0, s.opts.Location)
+	g := s.opts.SlotGranularity
+	if lst.Sub(day)%g != 0 || et.Sub(st)%g != 0 {
+		return fmt.Errorf("%w: start/end must align to %s slots", ErrInvalidArgument, g)
+	}
+
+	// เช็คทั้งรอบเปิดของวันนั้นและของเมื่อวาน (กรณีเปิดเลยเที่ยงคืน)
+	for _, d := range []time.Time{day, day.AddDate(0, 0, -1)} {
+		open, closeAt, err := openingWindow(court, d)
+		if err != nil {
+			return fmt.Errorf("court opening hours: %w", err)
+		}
+		if !st.Before(open) && !et.After(closeAt) {
+			return nil
+		}
 	}
+	return fmt.Errorf("%w: slot is outside opening hours %s-%s", ErrInvalidArgument, court.OpenFrom, court.OpenTo)
+}
 
-	b := &domain.Booking{UserID: userID, CourtID: courtID, StartTime: st, EndTime: et, Status: "PENDING"}
-	if err := s.repo.CreateWithNoOverlap(ctx, b); err != nil {
+// Create จอง (PENDING) ตามราคาที่ server คำนวณ; promoCode ไม่ว่าง = หักส่วนลดและกันสิทธิ์ใช้โค้ดไปพร้อมกัน
+func (s *BookingSvc) Create(ctx context.Context, userID, courtID, startISO, endISO, promoCode string) (*domain.Booking, error) {
+	userID, err := bookerFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	st, err := parseRFC3339UTC(startISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start_iso must be RFC3339", ErrInvalidArgument)
+	}
+	et, err := parseRFC3339UTC(endISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: end_iso must be RFC3339", ErrInvalidArgument)
+	}
+	court, err := s.validateSlot(ctx, courtID, st, et)
+	if err != nil {
 		return nil, err
 	}
 
-	_ = s.pub.PublishJSON(ctx, "booking.created", map[string]any{
-		"booking_id": b.ID, "user_id": b.UserID, "court_id": b.CourtID,
-		"start": b.StartTime.Unix(), "end": b.EndTime.Unix(),
-	})
+	q := s.priceFor(court, st, et)
+	if err := s.applyPromo(ctx, &q, promoCode, court, st, et, userID, false); err != nil {
+		return nil, err
+	}
+	exp := time.Now().UTC().Add(s.opts.HoldTTL)
+	b := &domain.Booking{
+		UserID: userID, CourtID: courtID, StartTime: st, EndTime: et,
+		Status: domain.StatusPending, ExpiresAt: &exp, Amount: q.Amount, Currency: q.Currency,
+		PromoCode: q.PromoCode, Discount: q.Discount,
+	}
+	if err := s.repo.CreateWithNoOverlap(ctx, b, emitCreated(ctx)); err != nil {
+		return nil, err
+	}
 	return b, nil
 }
 
 func (s *BookingSvc) Confirm(ctx context.Context, id string) (*domain.Booking, error) {
-	b, err := s.repo.UpdateStatus(ctx, id, "CONFIRMED")
+	cur, err := s.repo.ByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	_ = s.pub.PublishJSON(ctx, "booking.confirmed", map[string]any{"booking_id": b.ID})
-	return b, nil
+	if err := s.authorizeOwnerAction(ctx, cur); err != nil {
+		return nil, err
+	}
+	return s.repo.Transition(ctx, id, domain.StatusConfirmed, actorName(ctx, "system:booking"), "", emitConfirmed(ctx))
+}
+
+func (s *BookingSvc) Cancel(ctx context.Context, id, reason string) (*domain.Booking, error) {
+	cur, err := s.repo.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, cur); err != nil {
+		return nil, err
+	}
+	return s.repo.Transition(ctx, id, domain.StatusCancelled, actorName(ctx, "system:booking"), reason, emitCancelled(ctx, reason, s.refund(ctx)))
 }
 
-func (s *BookingSvc) Cancel(ctx context.Context, id string) (*domain.Booking, error) {
-	b, err := s.repo.UpdateStatus(ctx, id, "CANCELLED")
+// MarkOutcome ปิด booking ที่ CONFIRMED หลังเวลาเล่น: COMPLETED หรือ NO_SHOW (เฉพาะเจ้าของสนาม/ADMIN)
+func (s *BookingSvc) MarkOutcome(ctx context.Context, id, to, reason string) (*domain.Booking, error) {
+	if to != domain.StatusCompleted && to != domain.StatusNoShow {
+		return nil, fmt.Errorf("%w: status must be COMPLETED or NO_SHOW", ErrInvalidArgument)
+	}
+	cur, err := s.repo.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeOwnerAction(ctx, cur); err != nil {
+		return nil, err
+	}
+	if time.Now().UTC().Before(cur.StartTime) {
+		return nil, fmt.Errorf("%w: booking has not started yet", ErrFailedPrecondition)
+	}
+	return s.repo.Transition(ctx, id, to, actorName(ctx, "system:booking"), reason, emitOutcome(ctx))
+}
+
+// History ประวัติการเปลี่ยนสถานะ (สิทธิ์เดียวกับการดู booking)
+func (s *BookingSvc) History(ctx context.Context, id string) ([]domain.BookingStatusHistory, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.History(ctx, id)
+}
+
+// Reschedule ย้าย booking ไปช่วงเวลา/สนามใหม่แบบ atomic (id เดิม จึงยังผูกกับ payment เดิม)
+// newCourtID ว่าง = สนามเดิม; booking ที่ชำระแล้วย้ายได้เฉพาะเมื่อราคาใหม่เท่ากับที่จ่ายไป
+func (s *BookingSvc) Reschedule(ctx context.Context, id, newStartISO, newEndISO, newCourtID string) (*domain.Booking, error) {
+	st, err := parseRFC3339UTC(newStartISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start_iso must be RFC3339", ErrInvalidArgument)
+	}
+	et, err := parseRFC3339UTC(newEndISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: end_iso must be RFC3339", ErrInvalidArgument)
+	}
+	cur, err := s.repo.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, cur); err != nil {
+		return nil, err
+	}
+	courtID := newCourtID
+	if courtID == "" {
+		courtID = cur.CourtID
+	}
+	court, err := s.validateSlot(ctx, courtID, st, et)
+	if err != nil {
+		return nil, err
+	}
+	q := s.priceFor(court, st, et)
+	// โค้ดที่กันสิทธิ์ไว้แล้วต้องยังใช้กับช่องใหม่ได้ (ไม่นับ limit ซ้ำ)
+	if err := s.applyPromo(ctx, &q, cur.PromoCode, court, st, et, cur.UserID, true); err != nil {
+		return nil, err
+	}
+
+	var prev domain.Booking
+	_, b, err := s.repo.Reschedule(ctx, id, func(b *domain.Booking) error {
+		prev = *b
+		switch {
+		case b.HoldExpired(time.Now().UTC()):
+			// sweeper ยังไม่มาเปลี่ยนเป็น EXPIRED แต่ช่องเดิมถูกปล่อยแล้ว: ไม่มีอะไรให้ย้าย
+			return fmt.Errorf("%w: booking hold has expired", ErrFailedPrecondition)
+		case b.Status == domain.StatusPending:
+			if b.Splitting() && b.Amount != q.Amount {
+				return fmt.Errorf("%w: booking is split into shares; new slot must cost the same %d %s", ErrFailedPrecondition, b.Amount, b.Currency)
+			}
+			b.Amount, b.Currency, b.Discount = q.Amount, q.Currency, q.Discount
+		case b.Status == domain.StatusConfirmed:
+			if b.Amount != q.Amount || b.Currency != q.Currency {
+				return fmt.Errorf("%w: new slot costs %d %s but %d %s was paid", ErrFailedPrecondition, q.Amount, q.Currency, b.Amount, b.Currency)
+			}
+		default:
+			return fmt.Errorf("%w: cannot reschedule a %s booking", ErrFailedPrecondition, b.Status)
+		}
+		b.CourtID, b.StartTime, b.EndTime = courtID, st, et
+		return nil
+	}, emitRescheduled(ctx, &prev))
 	if err != nil {
 		return nil, err
 	}
-	_ = s.pub.PublishJSON(ctx, "booking.cancelled", map[string]any{"booking_id": b.ID})
 	return b, nil
 }
 
+// PaymentFailed บันทึกการชำระเงินที่ล้มเหลว และยกเลิก booking ถ้าล้มเหลวครบ MaxPaymentFailures
+// หรือ code อยู่ใน TerminalFailureCodes (ปล่อย booking.cancelled พร้อมเหตุผล)
+// eventID (ต่อ charge) จำเป็น: ความล้มเหลวที่ไม่มี charge กันซ้ำไม่ได้จึงไม่นับ
+func (s *BookingSvc) PaymentFailed(ctx context.Context, bookingID, eventID, code string) (*domain.Booking, error) {
+	if eventID == "" {
+		return nil, fmt.Errorf("%w: payment failure without a charge id is not counted", ErrInvalidArgument)
+	}
+	if code == "" {
+		code = "unknown"
+	}
+	terminal := slices.Contains(s.opts.TerminalFailureCodes, code)
+	reason := "payment failed: " + code
+	b, _, err := s.repo.RecordPaymentFailure(ctx, bookingID, eventID, code, reason, func(b *domain.Booking) bool {
+		return terminal || b.PaymentFailures >= s.opts.MaxPaymentFailures
+	}, emitCancelled(ctx, reason, nil))
+	return b, err
+}
+
+// PaymentPaid confirm booking จาก payment.paid (idempotent ด้วย PaymentID)
+// booking ที่ confirm ไม่ได้แล้วจะปล่อย booking.payment_rejected (ให้คืนเงิน) และคืน error ที่ห่อ domain.ErrIllegalTransition
+func (s *BookingSvc) PaymentPaid(ctx context.Context, p events.PaymentPaid) (*domain.Booking, error) {
+	return s.repo.ConfirmIfNotProcessed(ctx, p.BookingID, p.PaymentID, events.RKPaymentPaid, emitConfirmed(ctx), emitRejected(ctx, p))
+}
+
+// ExpireHolds เปลี่ยน PENDING ที่หมดเวลา hold เป็น EXPIRED แล้วปล่อย booking.expired
+func (s *BookingSvc) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	expired, err := s.repo.ExpireHolds(ctx, time.Now().UTC(), limit, emitExpired(ctx))
+	if err != nil {
+		return 0, err
+	}
+	return len(expired), nil
+}
+
+// Get พร้อม Shares (ความคืบหน้าของการหารจ่าย); ผู้ถูกเชิญให้หารจ่ายดูได้ด้วย
 func (s *BookingSvc) Get(ctx context.Context, id string) (*domain.Booking, error) {
-	return s.repo.ByID(ctx, id)
+	b, err := s.repo.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.WithShares(ctx, b); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeView(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
 }
+
+// List จำกัดผลตามสิทธิ์: USER เห็นเฉพาะของตัวเอง, OWNER เห็นของตัวเอง + บนสนามที่เป็นเจ้าของ
 func (s *BookingSvc) List(ctx context.Context, page, size int32, userID, courtID, dayISO string) ([]domain.Booking, int64, error) {
-	return s.repo.List(ctx, page, size, userID, courtID, dayISO)
+	f := repository.ListFilter{UserID: userID, CourtID: courtID, DayISO: dayISO}
+	if a, ok := ActorFrom(ctx); ok && a.Role != RoleAdmin {
+		switch {
+		case userID == a.UserID:
+			// ของตัวเองเสมอ
+		case a.Role == RoleOwner:
+			owned, err := s.ownedCourtIDs(ctx, a.UserID)
+			if err != nil {
+				return nil, 0, err
+			}
+			f.VisibleTo, f.VisibleCourts = a.UserID, owned
+		case userID == "":
+			f.UserID = a.UserID
+		default:
+			return nil, 0, fmt.Errorf("%w: cannot list other users' bookings", ErrPermissionDenied)
+		}
+	}
+	return s.repo.List(ctx, page, size, f)
 }
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+	"github.com/you/badminton-booking/services/booking-service/internal/repository"
+)
+
+// booking.* events ทั้งหมดเขียนผ่าน outbox ใน txn เดียวกับการเปลี่ยนข้อมูล (relay ใน cmd/booking เป็นคน publish)
+// payload เป็น events.Envelope ตามสัญญาใน pkg/events; ctx ใช้แนบ trace context
+
+func envelope[T any](ctx context.Context, key string, data T) []outbox.Event {
+	return []outbox.Event{{Key: key, Payload: events.New(ctx, key, data)}}
+}
+
+func statusOf(b *domain.Booking) events.BookingStatus {
+	return events.BookingStatus{BookingID: b.ID, UserID: b.UserID, CourtID: b.CourtID}
+}
+
+func emitCreated(ctx context.Context) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		return envelope(ctx, events.RKBookingCreated, events.BookingCreated{
+			BookingID: b.ID, UserID: b.UserID, CourtID: b.CourtID,
+			Start: b.StartTime.Unix(), End: b.EndTime.Unix(), SeriesID: b.SeriesID,
+			Amount: b.Amount, Currency: b.Currency,
+		})
+	}
+}
+
+func emitConfirmed(ctx context.Context) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		return envelope(ctx, events.RKBookingConfirmed, statusOf(b))
+	}
+}
+
+// emitCancelled booking.cancelled พร้อมเหตุผล (ว่างได้) และยอดที่ payment-service ต้องคืน (refund = nil คือไม่คืน)
+// booking หารจ่ายคืนแยกตาม charge ของแต่ละส่วนที่จ่ายแล้ว (Refunds)
+func emitCancelled(ctx context.Context, reason string, refund func(b *domain.Booking, paid int64) int64) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		ev := events.BookingCancelled{
+			BookingID: b.ID, UserID: b.UserID, CourtID: b.CourtID, SeriesID: b.SeriesID, Reason: reason,
+		}
+		if refund != nil {
+			if shares := b.PaidShares(); len(shares) > 0 {
+				for _, sh := range shares {
+					if amt := refund(b, sh.Amount); amt > 0 {
+						ev.Refunds = append(ev.Refunds, events.Refund{PaymentID: sh.PaymentID, Amount: amt})
+						ev.Currency = b.Currency
+					}
+				}
+			} else if b.PaymentID != "" {
+				if amt := refund(b, b.Amount); amt > 0 {
+					ev.PaymentID, ev.RefundAmount, ev.Currency = b.PaymentID, amt, b.Currency
+				}
+			}
+		}
+		return envelope(ctx, events.RKBookingCancelled, ev)
+	}
+}
+
+// emitRejected booking.payment_rejected ของเงินจาก p ที่ booking นำไปใช้ไม่ได้ (why = เหตุผลจาก repository)
+func emitRejected(ctx context.Context, p events.PaymentPaid) repository.Reject {
+	return func(b *domain.Booking, why error) []outbox.Event {
+		return envelope(ctx, events.RKBookingPaymentRejected, events.PaymentRejected{
+			BookingID: b.ID, UserID: b.UserID, PaymentID: p.PaymentID, ShareID: p.ShareID,
+			Amount: p.Amount, Currency: p.Currency, Reason: why.Error(),
+		})
+	}
+}
+
+// emitOutcome booking.completed / booking.no_show
+func emitOutcome(ctx context.Context) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		key := events.RKBookingCompleted
+		if b.Status == domain.StatusNoShow {
+			key = events.RKBookingNoShow
+		}
+		return envelope(ctx, key, statusOf(b))
+	}
+}
+
+// emitExpired booking หารจ่ายที่จ่ายไม่ครบก่อน hold หมด: คืนเต็มทุกส่วนที่จ่ายแล้ว
+func emitExpired(ctx context.Context) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		ev := statusOf(b)
+		for _, sh := range b.PaidShares() {
+			ev.Refunds = append(ev.Refunds, events.Refund{PaymentID: sh.PaymentID, Amount: sh.Amount})
+			ev.Currency = b.Currency
+		}
+		return envelope(ctx, events.RKBookingExpired, ev)
+	}
+}
+
+// emitSplit booking.split ให้ notification-service ส่งคำเชิญจ่ายส่วนที่ยังรออยู่
+func emitSplit(ctx context.Context) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		ev := events.BookingSplit{
+			BookingID: b.ID, UserID: b.UserID, CourtID: b.CourtID,
+			Start: b.StartTime.Unix(), End: b.EndTime.Unix(), Currency: b.Currency,
+		}
+		if b.ExpiresAt != nil {
+			ev.ExpiresAt = b.ExpiresAt.Unix()
+		}
+		for _, sh := range b.Shares {
+			if sh.Status == domain.SharePending {
+				ev.Shares = append(ev.Shares, events.ShareInvite{ShareID: sh.ID, UserID: sh.UserID, Email: sh.Email, Amount: sh.Amount})
+			}
+		}
+		return envelope(ctx, events.RKBookingSplit, ev)
+	}
+}
+
+// emitRescheduled ต้องได้ค่าก่อนย้าย (old) ซึ่ง service เก็บไว้ตอน apply
+func emitRescheduled(ctx context.Context, old *domain.Booking) repository.Emit {
+	return func(b *domain.Booking) []outbox.Event {
+		return envelope(ctx, events.RKBookingRescheduled, events.BookingRescheduled{
+			BookingID: b.ID, UserID: b.UserID,
+			OldCourtID: old.CourtID, OldStart: old.StartTime.Unix(), OldEnd: old.EndTime.Unix(),
+			CourtID: b.CourtID, Start: b.StartTime.Unix(), End: b.EndTime.Unix(),
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// decodeOne ผ่าน outbox แบบ relay (json.Marshal ของ Payload) แล้วอ่านด้วย events.Decode[T] แบบ consumer
+func decodeOne[T any](t *testing.T, evs []outbox.Event, key string) T {
+	t.Helper()
+	if len(evs) != 1 {
+		t.Fatalf("got %d events, want 1", len(evs))
+	}
+	if evs[0].Key != key {
+		t.Fatalf("key = %s, want %s", evs[0].Key, key)
+	}
+	body, err := json.Marshal(evs[0].Payload)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	ev, err := events.Decode[T](body)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if ev.Type != key {
+		t.Fatalf("envelope type = %s, want %s", ev.Type, key)
+	}
+	return ev.Data
+}
+
+func testBooking() *domain.Booking {
+	start := time.Date(2025, 1, 7, 19, 0, 0, 0, time.UTC)
+	exp := start.Add(-time.Hour)
+	return &domain.Booking{
+		ID: "b1", UserID: "u1", CourtID: "c1", StartTime: start, EndTime: start.Add(2 * time.Hour),
+		Status: domain.StatusPending, ExpiresAt: &exp, Amount: 40000, Currency: "thb", SeriesID: "s1",
+	}
+}
+
+func splitBooking() *domain.Booking {
+	b := testBooking()
+	b.Shares = []domain.PaymentShare{
+		{ID: "sh1", UserID: "u1", Amount: 20000, Status: domain.SharePaid, PaymentID: "chrg_1", Organizer: true},
+		{ID: "sh2", Email: "friend@example.com", Amount: 20000, Status: domain.SharePending},
+		{ID: "sh3", UserID: "u3", Amount: 10000, Status: domain.ShareCancelled},
+	}
+	return b
+}
+
+func TestBookingEventContracts(t *testing.T) {
+	ctx := context.Background()
+	half := func(_ *domain.Booking, paid int64) int64 { return paid / 2 }
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{"created", func(t *testing.T) {
+			b := testBooking()
+			got := decodeOne[events.BookingCreated](t, emitCreated(ctx)(b), events.RKBookingCreated)
+			want := events.BookingCreated{BookingID: "b1", UserID: "u1", CourtID: "c1", Start: b.StartTime.Unix(), End: b.EndTime.Unix(), SeriesID: "s1", Amount: 40000, Currency: "thb"}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"confirmed", func(t *testing.T) {
+			got := decodeOne[events.BookingStatus](t, emitConfirmed(ctx)(testBooking()), events.RKBookingConfirmed)
+			if !reflect.DeepEqual(got, events.BookingStatus{BookingID: "b1", UserID: "u1", CourtID: "c1"}) {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"cancelled without refund", func(t *testing.T) {
+			b := testBooking()
+			b.PaymentID = "chrg_1"
+			got := decodeOne[events.BookingCancelled](t, emitCancelled(ctx, "by user", nil)(b), events.RKBookingCancelled)
+			want := events.BookingCancelled{BookingID: "b1", UserID: "u1", CourtID: "c1", SeriesID: "s1", Reason: "by user"}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"cancelled with refund", func(t *testing.T) {
+			b := testBooking()
+			b.PaymentID = "chrg_1"
+			got := decodeOne[events.BookingCancelled](t, emitCancelled(ctx, "", half)(b), events.RKBookingCancelled)
+			if got.PaymentID != "chrg_1" || got.RefundAmount != 20000 || got.Currency != "thb" || got.Refunds != nil {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"cancelled split", func(t *testing.T) {
+			got := decodeOne[events.BookingCancelled](t, emitCancelled(ctx, "", half)(splitBooking()), events.RKBookingCancelled)
+			want := []events.Refund{{PaymentID: "chrg_1", Amount: 10000}}
+			if !reflect.DeepEqual(got.Refunds, want) || got.PaymentID != "" || got.Currency != "thb" {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"payment rejected", func(t *testing.T) {
+			p := events.PaymentPaid{PaymentID: "chrg_9", BookingID: "b1", Amount: 40000, Currency: "thb", Method: "card", ShareID: "sh2"}
+			evs := emitRejected(ctx, p)(testBooking(), errors.New("booking is CANCELLED"))
+			got := decodeOne[events.PaymentRejected](t, evs, events.RKBookingPaymentRejected)
+			want := events.PaymentRejected{BookingID: "b1", UserID: "u1", PaymentID: "chrg_9", ShareID: "sh2", Amount: 40000, Currency: "thb", Reason: "booking is CANCELLED"}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"completed", func(t *testing.T) {
+			b := testBooking()
+			b.Status = domain.StatusCompleted
+			decodeOne[events.BookingStatus](t, emitOutcome(ctx)(b), events.RKBookingCompleted)
+		}},
+		{"no show", func(t *testing.T) {
+			b := testBooking()
+			b.Status = domain.StatusNoShow
+			decodeOne[events.BookingStatus](t, emitOutcome(ctx)(b), events.RKBookingNoShow)
+		}},
+		{"expired split", func(t *testing.T) {
+			got := decodeOne[events.BookingStatus](t, emitExpired(ctx)(splitBooking()), events.RKBookingExpired)
+			want := []events.Refund{{PaymentID: "chrg_1", Amount: 20000}}
+			if !reflect.DeepEqual(got.Refunds, want) || got.Currency != "thb" {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"split", func(t *testing.T) {
+			b := splitBooking()
+			got := decodeOne[events.BookingSplit](t, emitSplit(ctx)(b), events.RKBookingSplit)
+			want := []events.ShareInvite{{ShareID: "sh2", Email: "friend@example.com", Amount: 20000}}
+			if !reflect.DeepEqual(got.Shares, want) || got.ExpiresAt != b.ExpiresAt.Unix() || got.Start != b.StartTime.Unix() {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"rescheduled", func(t *testing.T) {
+			old := testBooking()
+			b := testBooking()
+			b.CourtID, b.StartTime, b.EndTime = "c2", old.StartTime.Add(24*time.Hour), old.EndTime.Add(24*time.Hour)
+			got := decodeOne[events.BookingRescheduled](t, emitRescheduled(ctx, old)(b), events.RKBookingRescheduled)
+			want := events.BookingRescheduled{BookingID: "b1", UserID: "u1",
+				OldCourtID: "c1", OldStart: old.StartTime.Unix(), OldEnd: old.EndTime.Unix(),
+				CourtID: "c2", Start: b.StartTime.Unix(), End: b.EndTime.Unix()}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
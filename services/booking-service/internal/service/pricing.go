@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// Quote ราคาที่ server คำนวณให้ booking หนึ่งช่วงเวลา
+type Quote struct {
+	Amount       int64 // หน่วยย่อย (สตางค์) ตามที่ Omise ใช้ หลังหักส่วนลดแล้ว
+	Currency     string
+	PricePerHour int64 // หน่วยหลัก (บาท) ตาม court-service
+	Minutes      int32
+	Discount     int64  // ส่วนลดจาก PromoCode (สตางค์)
+	PromoCode    string // โค้ดที่ใช้ได้ (normalize แล้ว)
+}
+
+// priceFor คิดราคาจาก PricePerHour (บาท/ชม.) x ระยะเวลา แล้วแปลงเป็นสตางค์ (ปัดครึ่งขึ้น)
+func (s *BookingSvc) priceFor(c *courtv1.Court, st, et time.Time) Quote {
+	minutes := int64(et.Sub(st) / time.Minute)
+	return Quote{
+		Amount:       (c.PricePerHour*100*minutes + 30) / 60,
+		Currency:     s.opts.Currency,
+		PricePerHour: c.PricePerHour,
+		Minutes:      int32(minutes),
+	}
+}
+
+// QuoteBooking คืนราคาของ booking ที่รอจ่าย (bookingID; FailedPrecondition ถ้าไม่ใช่ PENDING) หรือราคาก่อนจองของ courtID+start/end
+// promoCode ใช้กับราคาก่อนจองเท่านั้น (booking ที่มีอยู่ยึดส่วนลดตอนจอง)
+func (s *BookingSvc) QuoteBooking(ctx context.Context, bookingID, courtID, startISO, endISO, promoCode string) (*Quote, error) {
+	if bookingID != "" {
+		b, err := s.Get(ctx, bookingID)
+		if err != nil {
+			return nil, err
+		}
+		// ราคาของ booking ใช้ตอนจะจ่ายเงิน: เฉพาะ PENDING ที่ hold ยังไม่หมด
+		if b.Status != domain.StatusPending {
+			return nil, fmt.Errorf("%w: booking is %s", ErrFailedPrecondition, b.Status)
+		}
+		if b.HoldExpired(time.Now().UTC()) {
+			return nil, fmt.Errorf("%w: booking hold has expired", ErrFailedPrecondition)
+		}
+		res, err := s.court.GetCourt(ctx, &courtv1.GetCourtRequest{Id: b.CourtID})
+		if err != nil {
+			return nil, err
+		}
+		q := s.priceFor(res.GetCourt(), b.StartTime, b.EndTime)
+		// booking ที่คิดราคาไว้แล้วยึดราคาตอนจอง (ราคาสนามอาจเปลี่ยนภายหลัง)
+		if b.Amount > 0 {
+			q.Amount, q.Currency = b.Amount, b.Currency
+			q.Discount, q.PromoCode = b.Discount, b.PromoCode
+		}
+		return &q, nil
+	}
+
+	st, err := parseRFC3339UTC(startISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start_iso must be RFC3339", ErrInvalidArgument)
+	}
+	et, err := parseRFC3339UTC(endISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: end_iso must be RFC3339", ErrInvalidArgument)
+	}
+	court, err := s.validateSlot(ctx, courtID, st, et)
+	if err != nil {
+		return nil, err
+	}
+	q := s.priceFor(court, st, et)
+	if promoCode != "" {
+		a, _ := ActorFrom(ctx)
+		if err := s.applyPromo(ctx, &q, promoCode, court, st, et, a.UserID, false); err != nil {
+			return nil, err
+		}
+	}
+	return &q, nil
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// NormalizePromoCode โค้ดเทียบแบบไม่สนตัวพิมพ์/ช่องว่าง
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// applyPromo หักส่วนลดของ code ออกจาก q ถ้า booking (court, st-et, userID) เข้าเงื่อนไข
+// เช็ค limit ตรงนี้เพื่อบอกผู้ใช้ตั้งแต่ quote; ตอนจองจริง repository เช็คซ้ำภายใต้ lock (reservePromo)
+// reserved = booking นี้กันสิทธิ์ไว้แล้ว (reschedule) จึงไม่ต้องนับ limit อีก
+func (s *BookingSvc) applyPromo(ctx context.Context, q *Quote, code string, court *courtv1.Court, st, et time.Time, userID string, reserved bool) error {
+	code = NormalizePromoCode(code)
+	if code == "" {
+		return nil
+	}
+	p, err := s.repo.Promotion(ctx, code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: promo code %s not found", ErrInvalidArgument, code)
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.promoApplies(ctx, p, q, court, st, et, userID, reserved); err != nil {
+		return fmt.Errorf("%w: promo code %s %s", ErrFailedPrecondition, code, err.Error())
+	}
+
+	d := promoDiscount(p, q.Amount, s.opts.MinChargeAmount)
+	q.Amount -= d
+	q.Discount, q.PromoCode = d, p.Code
+	return nil
+}
+
+// promoDiscount ส่วนลดของ p จากยอด amount โดยยอดหลังหักต้องไม่ต่ำกว่า floor (ยอดขั้นต่ำที่ gateway รับ)
+func promoDiscount(p *domain.Promotion, amount, floor int64) int64 {
+	var d int64
+	switch p.Kind {
+	case domain.PromoPercent:
+		d = (amount*p.Value + 50) / 100
+		if p.MaxDiscount > 0 && d > p.MaxDiscount {
+			d = p.MaxDiscount
+		}
+	case domain.PromoFixed:
+		d = p.Value
+	}
+	return max(min(d, amount-floor), 0)
+}
+
+// promoApplies คืน error ที่อ่านเป็นประโยคต่อจาก "promo code X ..." ได้
+func (s *BookingSvc) promoApplies(ctx context.Context, p *domain.Promotion, q *Quote, court *courtv1.Court, st, et time.Time, userID string, reserved bool) error {
+	now := time.Now().UTC()
+	switch {
+	case !p.Active && !reserved:
+		return errors.New("is not active")
+	case p.StartsAt != nil && now.Before(*p.StartsAt):
+		return errors.New("is not valid yet")
+	case p.EndsAt != nil && !now.Before(*p.EndsAt) && !reserved:
+		return errors.New("has expired")
+	case q.Amount < p.MinAmount:
+		return fmt.Errorf("requires a minimum of %d", p.MinAmount)
+	}
+	if p.CourtIDs != "" && !slices.Contains(strings.Split(p.CourtIDs, ","), court.GetId()) {
+		return errors.New("is not valid for this court")
+	}
+	if p.Venue != "" && !strings.EqualFold(p.Venue, court.GetVenue()) {
+		return errors.New("is not valid for this venue")
+	}
+
+	lst, let := st.In(s.opts.Location), et.In(s.opts.Location)
+	if p.Weekdays != "" {
+		wd := int(lst.Weekday())
+		if wd == 0 {
+			wd = 7
+		}
+		if !slices.Contains(strings.Split(p.Weekdays, ","), strconv.Itoa(wd)) {
+			return errors.New("is not valid on this day")
+		}
+	}
+	if p.TimeFrom != "" || p.TimeTo != "" {
+		day := time.Date(lst.Year(), lst.Month(), lst.Day(), 0, 0, 0, 0, s.opts.Location)
+		from, to, err := clockWindow(day, p.TimeFrom, p.TimeTo)
+		if err != nil {
+			return err
+		}
+		if lst.Before(from) || let.After(to) {
+			return fmt.Errorf("is only valid for play between %s-%s", p.TimeFrom, p.TimeTo)
+		}
+	}
+
+	if reserved {
+		return nil
+	}
+	if p.NewMembersOnly {
+		paid, err := s.repo.HasPaidBooking(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if paid {
+			return errors.New("is for new members only")
+		}
+	}
+	if p.UsageLimit > 0 {
+		used, err := s.repo.PromoUsage(ctx, []string{p.Code})
+		if err != nil {
+			return err
+		}
+		if used[p.Code] >= int64(p.UsageLimit) {
+			return errors.New("has been fully redeemed")
+		}
+	}
+	if p.PerUserLimit > 0 && userID != "" {
+		mine, err := s.repo.UserPromoUses(ctx, p.Code, userID)
+		if err != nil {
+			return err
+		}
+		if mine >= int64(p.PerUserLimit) {
+			return fmt.Errorf("can be used %d time(s) per user", p.PerUserLimit)
+		}
+	}
+	return nil
+}
+
+// clockWindow ช่วง HH:mm ของวัน day (ว่าง = ต้นวัน/ท้ายวัน)
+func clockWindow(day time.Time, from, to string) (time.Time, time.Time, error) {
+	start, end := day, day.AddDate(0, 0, 1)
+	if from != "" {
+		t, err := time.Parse("15:04", from)
+		if err != nil {
+			return start, end, fmt.Errorf("has invalid time_from %q", from)
+		}
+		start = day.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute)
+	}
+	if to != "" {
+		t, err := time.Parse("15:04", to)
+		if err != nil {
+			return start, end, fmt.Errorf("has invalid time_to %q", to)
+		}
+		end = day.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute)
+	}
+	return start, end, nil
+}
+
+// requireAdmin จัดการโปรโมชันได้เฉพาะ ADMIN (หรือ service ภายใน)
+func requireAdmin(ctx context.Context) error {
+	if a, ok := ActorFrom(ctx); ok && a.Role != RoleAdmin {
+		return fmt.Errorf("%w: only admins can manage promotions", ErrPermissionDenied)
+	}
+	return nil
+}
+
+// CreatePromotion ตรวจค่าแล้วบันทึกโค้ดใหม่ (เปิดใช้ทันที)
+func (s *BookingSvc) CreatePromotion(ctx context.Context, p *domain.Promotion) (*domain.Promotion, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p.Code = NormalizePromoCode(p.Code)
+	if p.Code == "" {
+		return nil, fmt.Errorf("%w: code is required", ErrInvalidArgument)
+	}
+	switch p.Kind {
+	case domain.PromoPercent:
+		if p.Value < 1 || p.Value > 99 { // 100% = ยอด 0 ที่ชำระไม่ได้
+			return nil, fmt.Errorf("%w: percent value must be 1-99", ErrInvalidArgument)
+		}
+	case domain.PromoFixed:
+		if p.Value <= 0 {
+			return nil, fmt.Errorf("%w: fixed value must be positive", ErrInvalidArgument)
+		}
+	default:
+		return nil, fmt.Errorf("%w: kind must be PERCENT or FIXED", ErrInvalidArgument)
+	}
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidArgument)
+	}
+	if _, _, err := clockWindow(time.Time{}, p.TimeFrom, p.TimeTo); err != nil {
+		return nil, fmt.Errorf("%w: promotion %s", ErrInvalidArgument, err.Error())
+	}
+	for _, d := range strings.Split(p.Weekdays, ",") {
+		if n, err := strconv.Atoi(d); p.Weekdays != "" && (err != nil || n < 1 || n > 7) {
+			return nil, fmt.Errorf("%w: weekdays must be 1-7", ErrInvalidArgument)
+		}
+	}
+	if p.UsageLimit < 0 || p.PerUserLimit < 0 || p.MaxDiscount < 0 || p.MinAmount < 0 {
+		return nil, fmt.Errorf("%w: limits must not be negative", ErrInvalidArgument)
+	}
+	p.Active = true
+	if err := s.repo.CreatePromotion(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListPromotions คืนโปรโมชันพร้อมจำนวนที่ถูกใช้ (RESERVED + REDEEMED) ต่อโค้ด
+func (s *BookingSvc) ListPromotions(ctx context.Context, page, size int32, activeOnly bool) ([]domain.Promotion, map[string]int64, int64, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, nil, 0, err
+	}
+	list, total, err := s.repo.ListPromotions(ctx, page, size, activeOnly)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	codes := make([]string, len(list))
+	for i := range list {
+		codes[i] = list[i].Code
+	}
+	used, err := s.repo.PromoUsage(ctx, codes)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return list, used, total, nil
+}
+
+func (s *BookingSvc) SetPromotionActive(ctx context.Context, code string, active bool) (*domain.Promotion, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.SetPromotionActive(ctx, NormalizePromoCode(code), active)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// ยอดหลังหักส่วนลดต้องไม่ต่ำกว่ายอดขั้นต่ำของ gateway
+func TestPromoDiscount(t *testing.T) {
+	tests := []struct {
+		name   string
+		promo  domain.Promotion
+		amount int64
+		want   int64
+	}{
+		{"percent", domain.Promotion{Kind: domain.PromoPercent, Value: 10}, 40000, 4000},
+		{"percent capped by max", domain.Promotion{Kind: domain.PromoPercent, Value: 50, MaxDiscount: 5000}, 40000, 5000},
+		{"percent keeps minimum", domain.Promotion{Kind: domain.PromoPercent, Value: 99}, 40000, 38000},
+		{"fixed", domain.Promotion{Kind: domain.PromoFixed, Value: 10000}, 40000, 10000},
+		{"fixed above amount", domain.Promotion{Kind: domain.PromoFixed, Value: 50000}, 40000, 38000},
+		{"amount at minimum", domain.Promotion{Kind: domain.PromoFixed, Value: 500}, 2000, 0},
+		{"amount below minimum", domain.Promotion{Kind: domain.PromoFixed, Value: 500}, 1500, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := promoDiscount(&tt.promo, tt.amount, 2000); got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+	"github.com/you/badminton-booking/services/booking-service/internal/repository"
+)
+
+// maxOccurrences เพดานจำนวนครั้งของ series หนึ่ง (ประมาณ 1 ปีถ้ารายสัปดาห์)
+const maxOccurrences = 52
+
+// RecurrenceSpec กฎแบบ RRULE (FREQ=WEEKLY) ต้องระบุ UntilISO หรือ Count อย่างใดอย่างหนึ่ง
+type RecurrenceSpec struct {
+	IntervalWeeks int
+	UntilISO      string // RFC3339; ครั้งสุดท้ายต้องเริ่มไม่เกินเวลานี้
+	Count         int
+}
+
+// Rule แปลงเป็นข้อความ RRULE สำหรับเก็บลง series
+func (r RecurrenceSpec) Rule(until time.Time) string {
+	if r.Count > 0 {
+		return fmt.Sprintf("FREQ=WEEKLY;INTERVAL=%d;COUNT=%d", r.IntervalWeeks, r.Count)
+	}
+	return fmt.Sprintf("FREQ=WEEKLY;INTERVAL=%d;UNTIL=%s", r.IntervalWeeks, until.UTC().Format("20060102T150405Z"))
+}
+
+// SeriesResult ผลของ CreateRecurring: booking ที่สร้างได้ + ครั้งที่ชนกับ booking เดิม
+type SeriesResult struct {
+	Series    *domain.BookingSeries
+	Bookings  []*domain.Booking
+	Conflicts []*domain.Booking
+}
+
+// occurrences คำนวณช่วงเวลาของแต่ละครั้ง โดยเลื่อนตามปฏิทินท้องถิ่นเพื่อคงเวลาเดิมของวัน
+func (s *BookingSvc) occurrences(st, et time.Time, spec RecurrenceSpec) ([][2]time.Time, time.Time, error) {
+	var until time.Time
+	switch {
+	case spec.Count > 0 && spec.UntilISO != "":
+		return nil, until, fmt.Errorf("%w: specify either count or until_iso", ErrInvalidArgument)
+	case spec.Count > 0:
+		if spec.Count > maxOccurrences {
+			return nil, until, fmt.Errorf("%w: count must be <= %d", ErrInvalidArgument, maxOccurrences)
+		}
+	case spec.UntilISO != "":
+		u, err := parseRFC3339UTC(spec.UntilISO)
+		if err != nil {
+			return nil, until, fmt.Errorf("%w: until_iso must be RFC3339", ErrInvalidArgument)
+		}
+		if u.Before(st) {
+			return nil, until, fmt.Errorf("%w: until_iso is before the first occurrence", ErrInvalidArgument)
+		}
+		until = u
+	default:
+		return nil, until, fmt.Errorf("%w: count or until_iso is required", ErrInvalidArgument)
+	}
+
+	lst, let := st.In(s.opts.Location), et.In(s.opts.Location)
+	var out [][2]time.Time
+	for i := 0; ; i++ {
+		days := 7 * spec.IntervalWeeks * i
+		ost, oet := lst.AddDate(0, 0, days).UTC(), let.AddDate(0, 0, days).UTC()
+		if spec.Count > 0 && i >= spec.Count {
+			break
+		}
+		if !until.IsZero() && ost.After(until) {
+			break
+		}
+		if len(out) >= maxOccurrences {
+			return nil, until, fmt.Errorf("%w: series exceeds %d occurrences", ErrInvalidArgument, maxOccurrences)
+		}
+		out = append(out, [2]time.Time{ost, oet})
+	}
+	return out, until, nil
+}
+
+// CreateRecurring สร้าง booking รายสัปดาห์ทั้ง series ใน txn เดียว
+// skipConflicts=false: ถ้ามีครั้งไหนชนจะไม่สร้างเลย (all-or-nothing)
+// กรณีไม่มีอะไรถูกสร้าง จะคืน Series=nil พร้อมรายการที่ชน (ไม่ใช่ error)
+func (s *BookingSvc) CreateRecurring(ctx context.Context, userID, courtID, startISO, endISO string, spec RecurrenceSpec, skipConflicts bool) (*SeriesResult, error) {
+	userID, err := bookerFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	st, err := parseRFC3339UTC(startISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start_iso must be RFC3339", ErrInvalidArgument)
+	}
+	et, err := parseRFC3339UTC(endISO)
+	if err != nil {
+		return nil, fmt.Errorf("%w: end_iso must be RFC3339", ErrInvalidArgument)
+	}
+	if spec.IntervalWeeks <= 0 {
+		spec.IntervalWeeks = 1
+	}
+	occ, until, err := s.occurrences(st, et, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	court, err := s.validateSlot(ctx, courtID, st, et)
+	if err != nil {
+		return nil, err
+	}
+	// ทุกครั้งต้องผ่านกฎเดียวกับครั้งแรก (เวลาเปิด-ปิดต่างกันตามวัน, DST เลื่อนเวลา UTC)
+	for i, o := range occ[1:] {
+		if err := s.checkSlot(court, o[0], o[1]); err != nil {
+			return nil, fmt.Errorf("occurrence %d (%s): %w", i+2, o[0].In(s.opts.Location).Format("2006-01-02"), err)
+		}
+	}
+
+	now := time.Now().UTC()
+	children := make([]*domain.Booking, 0, len(occ))
+	for _, o := range occ {
+		q := s.priceFor(court, o[0], o[1])
+		exp := s.seriesHold(now, o[0])
+		children = append(children, &domain.Booking{
+			UserID: userID, CourtID: courtID, StartTime: o[0], EndTime: o[1],
+			Status: domain.StatusPending, ExpiresAt: &exp, Amount: q.Amount, Currency: q.Currency,
+		})
+	}
+	series := &domain.BookingSeries{UserID: userID, CourtID: courtID, Rule: spec.Rule(until), Status: domain.SeriesActive}
+
+	created, conflicts, err := s.repo.CreateSeries(ctx, series, children, skipConflicts, emitCreated(ctx))
+	res := &SeriesResult{Series: series, Bookings: created, Conflicts: conflicts}
+	if errors.Is(err, repository.ErrOverlap) {
+		// all-or-nothing ล้มเหลว (หรือชนทุกครั้ง): ไม่มีอะไรถูกสร้าง แต่คืนรายการที่ชนให้ผู้เรียก
+		res.Series, res.Bookings = nil, nil
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// seriesHold กำหนดจ่ายของแต่ละครั้งใน series: จ่ายทีละครั้งได้จนถึง SeriesPayLead ก่อนเริ่ม
+// แต่ไม่น้อยกว่า HoldTTL ปกติ (ครั้งแรก/ครั้งที่ใกล้ถึงแล้วต้องจ่ายภายใน hold เหมือนจองเดี่ยว)
+func (s *BookingSvc) seriesHold(now, start time.Time) time.Time {
+	exp := start.Add(-s.opts.SeriesPayLead)
+	if least := now.Add(s.opts.HoldTTL); exp.Before(least) {
+		exp = least
+	}
+	return exp
+}
+
+// CancelSeries ยกเลิกทุกครั้งที่ยังไม่เริ่มของ series (ยกเลิกครั้งเดียวใช้ Cancel ตามปกติ)
+func (s *BookingSvc) CancelSeries(ctx context.Context, seriesID, reason string) ([]domain.Booking, error) {
+	series, err := s.repo.SeriesByID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, &domain.Booking{ID: series.ID, UserID: series.UserID, CourtID: series.CourtID}); err != nil {
+		return nil, err
+	}
+	return s.repo.CancelSeries(ctx, seriesID, time.Now().UTC(), actorName(ctx, "system:booking"), reason, emitCancelled(ctx, reason, s.refund(ctx)))
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// RefundTier ยกเลิกก่อนเวลาเริ่มอย่างน้อย Before ได้คืน Percent% ของยอดที่จ่าย
+type RefundTier struct {
+	Before  time.Duration
+	Percent int
+}
+
+// ParseRefundPolicy แปลง "24h:100,0s:50" เป็น tiers (เรียง Before มากไปน้อย)
+// ตัวอย่างนี้: ก่อนเริ่ม >24 ชม. คืนเต็ม, ภายใน 24 ชม. คืนครึ่ง, เริ่มแล้วไม่คืน
+func ParseRefundPolicy(s string) ([]RefundTier, error) {
+	var tiers []RefundTier
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		before, pct, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("refund policy %q: want <duration>:<percent>", part)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(before))
+		if err != nil {
+			return nil, fmt.Errorf("refund policy %q: %w", part, err)
+		}
+		p, err := strconv.Atoi(strings.TrimSpace(pct))
+		if err != nil || p < 0 || p > 100 {
+			return nil, fmt.Errorf("refund policy %q: percent must be 0-100", part)
+		}
+		tiers = append(tiers, RefundTier{Before: d, Percent: p})
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].Before > tiers[j].Before })
+	return tiers, nil
+}
+
+// refundFor ยอดคืน (สตางค์) ของเงิน paid ที่จ่ายเข้ามาแล้ว เมื่อ b ถูกยกเลิก ณ now
+// เจ้าของสนาม/ADMIN เป็นฝ่ายยกเลิก booking ของคนอื่น = คืนเต็มเสมอ
+// booking หารจ่ายที่ยังจ่ายไม่ครบ (ไม่เคย CONFIRMED) = คืนเต็ม เพราะยังไม่ได้ครองช่องจริง
+func (s *BookingSvc) refundFor(ctx context.Context, b *domain.Booking, paid int64, now time.Time) int64 {
+	if paid <= 0 {
+		return 0
+	}
+	if b.PaymentID == "" {
+		return paid
+	}
+	if a, ok := ActorFrom(ctx); ok && a.UserID != b.UserID {
+		return paid
+	}
+	until := b.StartTime.Sub(now)
+	for _, t := range s.opts.RefundPolicy {
+		if until >= t.Before {
+			return paid * int64(t.Percent) / 100
+		}
+	}
+	return 0
+}
+
+// refund ใช้กับ emitCancelled: คิดยอดคืนของเงิน paid จาก booking ที่ล็อกอยู่ใน txn
+func (s *BookingSvc) refund(ctx context.Context) func(b *domain.Booking, paid int64) int64 {
+	now := time.Now().UTC()
+	return func(b *domain.Booking, paid int64) int64 { return s.refundFor(ctx, b, paid, now) }
+}
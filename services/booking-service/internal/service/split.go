@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// maxShares จำนวนส่วนสูงสุดต่อ booking (รวมส่วนของผู้จัด)
+const maxShares = 20
+
+// Participant ผู้ถูกเชิญให้หารจ่าย ระบุ UserID หรือ Email อย่างใดอย่างหนึ่ง
+type Participant struct {
+	UserID string
+	Email  string
+}
+
+// authorizeOrganizer เฉพาะผู้จองเอง (หรือ ADMIN) จัดการการหารจ่ายได้
+func authorizeOrganizer(ctx context.Context, b *domain.Booking) error {
+	a, ok := ActorFrom(ctx)
+	if !ok || a.Role == RoleAdmin || a.UserID == b.UserID {
+		return nil
+	}
+	return fmt.Errorf("%w: only the organiser can manage payment shares", ErrPermissionDenied)
+}
+
+// splittable booking ที่แบ่ง/ปรับส่วนแบ่งได้: PENDING ที่ยังไม่หมด hold
+func splittable(b *domain.Booking) error {
+	switch {
+	case b.Status != domain.StatusPending:
+		return fmt.Errorf("%w: booking is %s", ErrFailedPrecondition, b.Status)
+	case b.HoldExpired(time.Now().UTC()):
+		return fmt.Errorf("%w: booking hold has expired", ErrFailedPrecondition)
+	case b.SeriesID != "":
+		return fmt.Errorf("%w: bookings in a series cannot be split", ErrFailedPrecondition)
+	case b.Amount <= 0:
+		return fmt.Errorf("%w: booking has nothing to pay", ErrFailedPrecondition)
+	}
+	return nil
+}
+
+// extendSplitHold ขยาย hold ให้ผู้ร่วมหารมีเวลาจ่ายอีก SplitHoldTTL นับจากตอนนี้ แต่ไม่เกินเวลาเริ่ม (ไม่ลดเวลาที่เหลืออยู่)
+// hold ที่หมดแล้วไม่ต่อให้: slot อาจถูกจองไปแล้วโดยไม่ผ่าน lockOverlap
+func (s *BookingSvc) extendSplitHold(b *domain.Booking) {
+	now := time.Now().UTC()
+	if b.HoldExpired(now) {
+		return
+	}
+	exp := now.Add(s.opts.SplitHoldTTL)
+	if exp.After(b.StartTime) {
+		exp = b.StartTime
+	}
+	if b.ExpiresAt == nil || exp.After(*b.ExpiresAt) {
+		b.ExpiresAt = &exp
+	}
+}
+
+// SplitBooking แบ่งค่าสนามเท่า ๆ กันระหว่างผู้จัดกับผู้ถูกเชิญ (เศษสตางค์อยู่ในส่วนของผู้จัด)
+// แบ่งใหม่ได้จนกว่าจะมีคนจ่าย; แต่ละส่วนจ่ายผ่าน charge ปกติโดยส่ง share_id
+func (s *BookingSvc) SplitBooking(ctx context.Context, bookingID string, participants []Participant) (*domain.Booking, error) {
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("%w: at least one participant is required", ErrInvalidArgument)
+	}
+	if len(participants)+1 > maxShares {
+		return nil, fmt.Errorf("%w: at most %d participants", ErrInvalidArgument, maxShares-1)
+	}
+	seen := map[string]bool{}
+	for i, p := range participants {
+		p.UserID, p.Email = strings.TrimSpace(p.UserID), strings.ToLower(strings.TrimSpace(p.Email))
+		if (p.UserID == "") == (p.Email == "") {
+			return nil, fmt.Errorf("%w: participant %d needs either user_id or email", ErrInvalidArgument, i+1)
+		}
+		if p.Email != "" {
+			if _, err := mail.ParseAddress(p.Email); err != nil {
+				return nil, fmt.Errorf("%w: invalid email %q", ErrInvalidArgument, p.Email)
+			}
+		}
+		key := "u:" + p.UserID
+		if p.Email != "" {
+			key = "e:" + p.Email
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: participant %d is listed twice", ErrInvalidArgument, i+1)
+		}
+		seen[key] = true
+		participants[i] = p
+	}
+
+	return s.repo.ReplaceShares(ctx, bookingID, func(b *domain.Booking) ([]domain.PaymentShare, error) {
+		if err := authorizeOrganizer(ctx, b); err != nil {
+			return nil, err
+		}
+		if err := splittable(b); err != nil {
+			return nil, err
+		}
+		if len(b.PaidShares()) > 0 {
+			return nil, fmt.Errorf("%w: some shares are already paid", ErrFailedPrecondition)
+		}
+		for _, p := range participants {
+			if p.UserID == b.UserID {
+				return nil, fmt.Errorf("%w: the organiser already has a share", ErrInvalidArgument)
+			}
+		}
+		s.extendSplitHold(b)
+		n := int64(len(participants) + 1)
+		each := b.Amount / n
+		shares := []domain.PaymentShare{{UserID: b.UserID, Amount: b.Amount - each*(n-1), Organizer: true}}
+		for _, p := range participants {
+			shares = append(shares, domain.PaymentShare{UserID: p.UserID, Email: p.Email, Amount: each})
+		}
+		return shares, nil
+	}, emitSplit(ctx))
+}
+
+// CoverRemainder ผู้จัดรับจ่ายส่วนที่เหลือทั้งหมดเอง: ส่วนที่ยังไม่จ่ายถูกยกเลิกแล้วรวมเป็นส่วนใหม่ของผู้จัด
+// คืน booking ที่มีส่วนใหม่ (จ่ายด้วย share_id ของส่วนนั้น)
+func (s *BookingSvc) CoverRemainder(ctx context.Context, bookingID string) (*domain.Booking, error) {
+	return s.repo.ReplaceShares(ctx, bookingID, func(b *domain.Booking) ([]domain.PaymentShare, error) {
+		if err := authorizeOrganizer(ctx, b); err != nil {
+			return nil, err
+		}
+		if err := splittable(b); err != nil {
+			return nil, err
+		}
+		if !b.Splitting() {
+			return nil, fmt.Errorf("%w: booking is not split", ErrFailedPrecondition)
+		}
+		rest := b.Amount - b.PaidAmount()
+		if rest <= 0 {
+			return nil, fmt.Errorf("%w: nothing left to pay", ErrFailedPrecondition)
+		}
+		s.extendSplitHold(b)
+		return []domain.PaymentShare{{UserID: b.UserID, Amount: rest, Organizer: true}}, nil
+	}, nil)
+}
+
+// SharePaid บันทึกการจ่ายของส่วน p.ShareID จาก payment.paid; ครบทุกส่วน = CONFIRMED (booking.confirmed)
+// ส่วน/booking ที่ไม่รอเงินแล้วปล่อย booking.payment_rejected (ให้คืนเงิน) และคืน error ที่ห่อ domain.ErrIllegalTransition
+func (s *BookingSvc) SharePaid(ctx context.Context, p events.PaymentPaid) (*domain.Booking, error) {
+	return s.repo.PayShare(ctx, p.BookingID, p.ShareID, p.PaymentID, emitConfirmed(ctx), emitRejected(ctx, p))
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+)
+
+// แบ่งจ่ายแล้ว hold ต้องยาวพอให้ผู้ร่วมหารจ่าย แต่ไม่เกินเวลาเริ่มและไม่สั้นลง
+func TestExtendSplitHold(t *testing.T) {
+	s := &BookingSvc{opts: Options{SplitHoldTTL: 2 * time.Hour}}
+	now := time.Now().UTC()
+	at := func(d time.Duration) *time.Time { t := now.Add(d); return &t }
+
+	tests := []struct {
+		name  string
+		start time.Time
+		exp   *time.Time
+		want  time.Duration // จาก now
+	}{
+		{"extends a short hold", now.Add(24 * time.Hour), at(10 * time.Minute), 2 * time.Hour},
+		{"bounded by start", now.Add(time.Hour), at(10 * time.Minute), time.Hour},
+		{"keeps a longer hold", now.Add(48 * time.Hour), at(24 * time.Hour), 24 * time.Hour},
+		{"no hold", now.Add(24 * time.Hour), nil, 2 * time.Hour},
+		{"expired hold is not revived", now.Add(24 * time.Hour), at(-time.Minute), -time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &domain.Booking{Status: domain.StatusPending, StartTime: tt.start, ExpiresAt: tt.exp}
+			s.extendSplitHold(b)
+			if got := b.ExpiresAt.Sub(now); got < tt.want-time.Second || got > tt.want+time.Second {
+				t.Fatalf("expires in %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
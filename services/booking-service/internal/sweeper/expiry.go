@@ -0,0 +1,55 @@
+package sweeper
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/you/badminton-booking/services/booking-service/internal/service"
+)
+
+// batchSize จำนวน booking สูงสุดที่ expire ต่อหนึ่งรอบ (วนต่อจนหมดในรอบเดียวกัน)
+const batchSize = 100
+
+// ExpirySweeper ปล่อยช่องเวลาของ PENDING ที่ไม่ได้ชำระภายใน hold TTL
+type ExpirySweeper struct {
+	svc      *service.BookingSvc
+	interval time.Duration
+}
+
+func NewExpirySweeper(svc *service.BookingSvc, interval time.Duration) *ExpirySweeper {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &ExpirySweeper{svc: svc, interval: interval}
+}
+
+// Run วนทุก interval จนกว่า ctx จะถูก cancel (เรียกใน goroutine)
+func (w *ExpirySweeper) Run(ctx context.Context) {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		w.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *ExpirySweeper) sweep(ctx context.Context) {
+	for {
+		n, err := w.svc.ExpireHolds(ctx, batchSize)
+		if err != nil {
+			log.Printf("[booking-sweeper] expire error: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Printf("[booking-sweeper] expired %d booking(s)", n)
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
@@ -2,10 +2,20 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
 	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
 	"github.com/you/badminton-booking/services/booking-service/internal/domain"
+	"github.com/you/badminton-booking/services/booking-service/internal/repository"
 	"github.com/you/badminton-booking/services/booking-service/internal/service"
 )
 
@@ -19,33 +29,122 @@ func NewServer(s *service.BookingSvc) *Server {
 }
 
 func toPB(b *domain.Booking) *bookingv1.Booking {
-	return &bookingv1.Booking{
-		Id:       b.ID,
-		UserId:   b.UserID,
-		CourtId:  b.CourtID,
-		StartIso: b.StartTime.UTC().Format(time.RFC3339),
-		EndIso:   b.EndTime.UTC().Format(time.RFC3339),
-		Status:   statusToEnum(b.Status),
+	pb := &bookingv1.Booking{
+		Id:        b.ID,
+		UserId:    b.UserID,
+		CourtId:   b.CourtID,
+		StartIso:  b.StartTime.UTC().Format(time.RFC3339),
+		EndIso:    b.EndTime.UTC().Format(time.RFC3339),
+		Status:    statusToEnum(b.Status),
+		Amount:    b.Amount,
+		Currency:  b.Currency,
+		SeriesId:  b.SeriesID,
+		PaymentId: b.PaymentID,
+		PromoCode: b.PromoCode,
+		Discount:  b.Discount,
+	}
+	if b.ExpiresAt != nil && b.Status == domain.StatusPending {
+		pb.ExpiresAtIso = b.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return pb
+}
+
+func sharesToPB(shares []domain.PaymentShare) []*bookingv1.PaymentShare {
+	out := make([]*bookingv1.PaymentShare, 0, len(shares))
+	for _, sh := range shares {
+		pb := &bookingv1.PaymentShare{
+			Id: sh.ID, UserId: sh.UserID, Email: sh.Email, Amount: sh.Amount,
+			Status: sh.Status, Organizer: sh.Organizer, PaymentId: sh.PaymentID,
+		}
+		if sh.PaidAt != nil {
+			pb.PaidAtIso = sh.PaidAt.UTC().Format(time.RFC3339)
+		}
+		out = append(out, pb)
+	}
+	return out
+}
+
+// progress ยอดที่จ่ายแล้ว/ยังรอจ่ายของ booking (หารจ่ายนับตามส่วน)
+func progress(b *domain.Booking) (paid, remaining int64) {
+	switch {
+	case b.Splitting():
+		paid = b.PaidAmount()
+		if b.Status == domain.StatusPending {
+			remaining = b.Amount - paid
+		}
+	case b.PaymentID != "":
+		paid = b.Amount
+	case b.Status == domain.StatusPending:
+		remaining = b.Amount
+	}
+	return paid, remaining
+}
+
+func first(ss []string) string {
+	if len(ss) > 0 {
+		return ss[0]
+	}
+	return ""
+}
+
+// ActorInterceptor อ่านตัวตนผู้เรียก (x-user-id / x-user-role ที่ gateway แนบมา) ใส่ ctx ให้ service ตรวจสิทธิ์
+// ไม่มี metadata = เรียกจาก service ภายใน
+func ActorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if id := first(md.Get("x-user-id")); id != "" {
+			ctx = service.WithActor(ctx, service.Actor{UserID: id, Role: first(md.Get("x-user-role")), Email: first(md.Get("x-user-email"))})
+		}
+	}
+	return handler(ctx, req)
+}
+
+// toStatus แปลง error ของ service/repository เป็น gRPC status
+// error ที่เป็น status อยู่แล้ว (เช่นจาก court-service) ส่งต่อไปตามเดิม
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrFailedPrecondition), errors.Is(err, domain.ErrIllegalTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, repository.ErrOverlap), errors.Is(err, repository.ErrPromoExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrPromoUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "booking not found")
+	default:
+		return status.Error(codes.Internal, err.Error())
 	}
 }
 
 func statusToEnum(s string) bookingv1.BookingStatus {
 	switch s {
-	case "PENDING":
+	case domain.StatusPending:
 		return bookingv1.BookingStatus_PENDING
-	case "CONFIRMED":
+	case domain.StatusConfirmed:
 		return bookingv1.BookingStatus_CONFIRMED
-	case "CANCELLED":
+	case domain.StatusCancelled:
 		return bookingv1.BookingStatus_CANCELLED
+	case domain.StatusExpired:
+		return bookingv1.BookingStatus_EXPIRED
+	case domain.StatusCompleted:
+		return bookingv1.BookingStatus_COMPLETED
+	case domain.StatusNoShow:
+		return bookingv1.BookingStatus_NO_SHOW
 	default:
 		return bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
 	}
 }
 
 func (s *Server) CreateBooking(ctx context.Context, in *bookingv1.CreateBookingRequest) (*bookingv1.CreateBookingResponse, error) {
-	b, err := s.svc.Create(ctx, in.UserId, in.CourtId, in.StartIso, in.EndIso)
+	b, err := s.svc.Create(ctx, in.UserId, in.CourtId, in.StartIso, in.EndIso, in.PromoCode)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 	return &bookingv1.CreateBookingResponse{Booking: toPB(b)}, nil
 }
@@ -53,15 +152,16 @@ func (s *Server) CreateBooking(ctx context.Context, in *bookingv1.CreateBookingR
 func (s *Server) GetBooking(ctx context.Context, in *bookingv1.GetBookingRequest) (*bookingv1.GetBookingResponse, error) {
 	b, err := s.svc.Get(ctx, in.Id)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
-	return &bookingv1.GetBookingResponse{Booking: toPB(b)}, nil
+	paid, remaining := progress(b)
+	return &bookingv1.GetBookingResponse{Booking: toPB(b), Shares: sharesToPB(b.Shares), PaidAmount: paid, Remaining: remaining}, nil
 }
 
 func (s *Server) ListBooking(ctx context.Context, in *bookingv1.ListBookingRequest) (*bookingv1.ListBookingResponse, error) {
 	list, total, err := s.svc.List(ctx, in.Page, in.PageSize, in.UserId, in.CourtId, in.DayIso)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 	resp := &bookingv1.ListBookingResponse{Total: total}
 	for i := range list {
@@ -73,15 +173,242 @@ func (s *Server) ListBooking(ctx context.Context, in *bookingv1.ListBookingReque
 func (s *Server) ConfirmBooking(ctx context.Context, in *bookingv1.ConfirmBookingRequest) (*bookingv1.ConfirmBookingResponse, error) {
 	b, err := s.svc.Confirm(ctx, in.Id)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 	return &bookingv1.ConfirmBookingResponse{Booking: toPB(b)}, nil
 }
 
 func (s *Server) CancelBooking(ctx context.Context, in *bookingv1.CancelBookingRequest) (*bookingv1.CancelBookingResponse, error) {
-	b, err := s.svc.Cancel(ctx, in.Id)
+	b, err := s.svc.Cancel(ctx, in.Id, in.Reason)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 	return &bookingv1.CancelBookingResponse{Booking: toPB(b)}, nil
 }
+
+func (s *Server) UpdateBookingStatus(ctx context.Context, in *bookingv1.UpdateBookingStatusRequest) (*bookingv1.UpdateBookingStatusResponse, error) {
+	var to string
+	switch in.Status {
+	case bookingv1.BookingStatus_COMPLETED:
+		to = domain.StatusCompleted
+	case bookingv1.BookingStatus_NO_SHOW:
+		to = domain.StatusNoShow
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be COMPLETED or NO_SHOW")
+	}
+	b, err := s.svc.MarkOutcome(ctx, in.Id, to, in.Reason)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.UpdateBookingStatusResponse{Booking: toPB(b)}, nil
+}
+
+func (s *Server) GetBookingHistory(ctx context.Context, in *bookingv1.GetBookingHistoryRequest) (*bookingv1.GetBookingHistoryResponse, error) {
+	list, err := s.svc.History(ctx, in.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &bookingv1.GetBookingHistoryResponse{}
+	for _, h := range list {
+		resp.Changes = append(resp.Changes, &bookingv1.BookingStatusChange{
+			FromStatus: statusToEnum(h.FromStatus),
+			ToStatus:   statusToEnum(h.ToStatus),
+			Actor:      h.Actor,
+			Reason:     h.Reason,
+			AtIso:      h.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetAvailability(ctx context.Context, in *bookingv1.GetAvailabilityRequest) (*bookingv1.GetAvailabilityResponse, error) {
+	a, err := s.svc.Availability(ctx, in.CourtId, in.Date)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &bookingv1.GetAvailabilityResponse{CourtId: a.CourtID, Date: a.Date, OpenFrom: a.OpenFrom, OpenTo: a.OpenTo}
+	for _, sl := range a.Slots {
+		resp.Slots = append(resp.Slots, &bookingv1.TimeSlot{
+			StartIso: sl.Start.UTC().Format(time.RFC3339),
+			EndIso:   sl.End.UTC().Format(time.RFC3339),
+			Free:     sl.Free,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) QuoteBooking(ctx context.Context, in *bookingv1.QuoteBookingRequest) (*bookingv1.QuoteBookingResponse, error) {
+	q, err := s.svc.QuoteBooking(ctx, in.BookingId, in.CourtId, in.StartIso, in.EndIso, in.PromoCode)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.QuoteBookingResponse{
+		Amount:       q.Amount,
+		Currency:     q.Currency,
+		PricePerHour: q.PricePerHour,
+		Minutes:      q.Minutes,
+		Discount:     q.Discount,
+		PromoCode:    q.PromoCode,
+	}, nil
+}
+
+func (s *Server) CreateRecurringBooking(ctx context.Context, in *bookingv1.CreateRecurringBookingRequest) (*bookingv1.CreateRecurringBookingResponse, error) {
+	spec := service.RecurrenceSpec{IntervalWeeks: int(in.IntervalWeeks), UntilISO: in.UntilIso, Count: int(in.Count)}
+	skip := in.ConflictMode == bookingv1.ConflictMode_SKIP_CONFLICTS
+	res, err := s.svc.CreateRecurring(ctx, in.UserId, in.CourtId, in.StartIso, in.EndIso, spec, skip)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &bookingv1.CreateRecurringBookingResponse{}
+	if res.Series != nil {
+		resp.Series = &bookingv1.BookingSeries{
+			Id: res.Series.ID, UserId: res.Series.UserID, CourtId: res.Series.CourtID,
+			Rule: res.Series.Rule, Status: res.Series.Status,
+		}
+	}
+	for _, b := range res.Bookings {
+		resp.Bookings = append(resp.Bookings, toPB(b))
+	}
+	for _, b := range res.Conflicts {
+		resp.Conflicts = append(resp.Conflicts, &bookingv1.OccurrenceConflict{
+			StartIso: b.StartTime.UTC().Format(time.RFC3339),
+			EndIso:   b.EndTime.UTC().Format(time.RFC3339),
+			Reason:   repository.ErrOverlap.Error(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) CancelBookingSeries(ctx context.Context, in *bookingv1.CancelBookingSeriesRequest) (*bookingv1.CancelBookingSeriesResponse, error) {
+	list, err := s.svc.CancelSeries(ctx, in.SeriesId, in.Reason)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &bookingv1.CancelBookingSeriesResponse{}
+	for i := range list {
+		resp.Bookings = append(resp.Bookings, toPB(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) RescheduleBooking(ctx context.Context, in *bookingv1.RescheduleBookingRequest) (*bookingv1.RescheduleBookingResponse, error) {
+	b, err := s.svc.Reschedule(ctx, in.Id, in.NewStartIso, in.NewEndIso, in.NewCourtId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.RescheduleBookingResponse{Booking: toPB(b)}, nil
+}
+
+// ---------- Promotions ----------
+
+func (s *Server) CreatePromotion(ctx context.Context, in *bookingv1.CreatePromotionRequest) (*bookingv1.CreatePromotionResponse, error) {
+	p, err := promoFromPB(in.GetPromotion())
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.svc.CreatePromotion(ctx, p)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.CreatePromotionResponse{Promotion: promoToPB(out, 0)}, nil
+}
+
+func (s *Server) ListPromotions(ctx context.Context, in *bookingv1.ListPromotionsRequest) (*bookingv1.ListPromotionsResponse, error) {
+	list, used, total, err := s.svc.ListPromotions(ctx, in.Page, in.PageSize, in.ActiveOnly)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &bookingv1.ListPromotionsResponse{Total: total}
+	for i := range list {
+		resp.Promotions = append(resp.Promotions, promoToPB(&list[i], used[list[i].Code]))
+	}
+	return resp, nil
+}
+
+func (s *Server) SetPromotionActive(ctx context.Context, in *bookingv1.SetPromotionActiveRequest) (*bookingv1.SetPromotionActiveResponse, error) {
+	p, err := s.svc.SetPromotionActive(ctx, in.Code, in.Active)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "promotion not found")
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.SetPromotionActiveResponse{Promotion: promoToPB(p, 0)}, nil
+}
+
+func promoFromPB(in *bookingv1.Promotion) (*domain.Promotion, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "promotion is required")
+	}
+	p := &domain.Promotion{
+		Code: in.Code, Description: in.Description, Kind: in.Kind, Value: in.Value,
+		MaxDiscount: in.MaxDiscount, MinAmount: in.MinAmount, TimeFrom: in.TimeFrom, TimeTo: in.TimeTo,
+		UsageLimit: int(in.UsageLimit), PerUserLimit: int(in.PerUserLimit), NewMembersOnly: in.NewMembersOnly,
+		CourtIDs: strings.Join(in.CourtIds, ","), Venue: in.Venue,
+	}
+	for _, f := range []struct {
+		iso string
+		dst **time.Time
+	}{{in.StartsAtIso, &p.StartsAt}, {in.EndsAtIso, &p.EndsAt}} {
+		if f.iso == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, f.iso)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "starts_at_iso/ends_at_iso must be RFC3339")
+		}
+		t = t.UTC()
+		*f.dst = &t
+	}
+	days := make([]string, len(in.Weekdays))
+	for i, d := range in.Weekdays {
+		days[i] = strconv.Itoa(int(d))
+	}
+	p.Weekdays = strings.Join(days, ",")
+	return p, nil
+}
+
+func promoToPB(p *domain.Promotion, used int64) *bookingv1.Promotion {
+	out := &bookingv1.Promotion{
+		Code: p.Code, Description: p.Description, Kind: p.Kind, Value: p.Value,
+		MaxDiscount: p.MaxDiscount, MinAmount: p.MinAmount, TimeFrom: p.TimeFrom, TimeTo: p.TimeTo,
+		UsageLimit: int32(p.UsageLimit), PerUserLimit: int32(p.PerUserLimit), NewMembersOnly: p.NewMembersOnly,
+		Venue: p.Venue, Active: p.Active, Used: used,
+	}
+	if p.StartsAt != nil {
+		out.StartsAtIso = p.StartsAt.UTC().Format(time.RFC3339)
+	}
+	if p.EndsAt != nil {
+		out.EndsAtIso = p.EndsAt.UTC().Format(time.RFC3339)
+	}
+	if p.Weekdays != "" {
+		for _, d := range strings.Split(p.Weekdays, ",") {
+			n, _ := strconv.Atoi(d)
+			out.Weekdays = append(out.Weekdays, int32(n))
+		}
+	}
+	if p.CourtIDs != "" {
+		out.CourtIds = strings.Split(p.CourtIDs, ",")
+	}
+	return out
+}
+
+func (s *Server) SplitBooking(ctx context.Context, in *bookingv1.SplitBookingRequest) (*bookingv1.SplitBookingResponse, error) {
+	ps := make([]service.Participant, len(in.Participants))
+	for i, p := range in.Participants {
+		ps[i] = service.Participant{UserID: p.UserId, Email: p.Email}
+	}
+	b, err := s.svc.SplitBooking(ctx, in.Id, ps)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.SplitBookingResponse{Booking: toPB(b), Shares: sharesToPB(b.Shares)}, nil
+}
+
+func (s *Server) CoverBookingShares(ctx context.Context, in *bookingv1.CoverBookingSharesRequest) (*bookingv1.CoverBookingSharesResponse, error) {
+	b, err := s.svc.CoverRemainder(ctx, in.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bookingv1.CoverBookingSharesResponse{Booking: toPB(b), Shares: sharesToPB(b.Shares)}, nil
+}
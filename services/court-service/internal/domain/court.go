@@ -7,5 +7,5 @@ type Court struct {
 	PricePerHour int64
 	OpenFrom     string // HH:mm
 	OpenTo       string // HH:mm
-	OwnerID      string // จาก JWT (role OWNER/ADMIN)
+	OwnerID      string `gorm:"index"` // จาก JWT (role OWNER/ADMIN)
 }
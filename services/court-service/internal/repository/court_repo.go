@@ -32,7 +32,7 @@ func (r *CourtRepo) ByID(ctx context.Context, id string) (*domain.Court, error)
 	}
 	return &c, nil
 }
-func (r *CourtRepo) List(ctx context.Context, page, size int32, venue string) ([]domain.Court, error) {
+func (r *CourtRepo) List(ctx context.Context, page, size int32, venue, ownerID string) ([]domain.Court, error) {
 	if size <= 0 {
 		size = 20
 	}
@@ -43,8 +43,11 @@ func (r *CourtRepo) List(ctx context.Context, page, size int32, venue string) ([
 	if venue != "" {
 		qb = qb.Where("venue ILIKE ?", "%"+venue+"%")
 	}
+	if ownerID != "" {
+		qb = qb.Where("owner_id = ?", ownerID)
+	}
 	var out []domain.Court
-	if err := qb.Limit(int(size)).Offset(int(page * size)).Find(&out).Error; err != nil {
+	if err := qb.Order("id ASC").Limit(int(size)).Offset(int(page * size)).Find(&out).Error; err != nil {
 		return nil, err
 	}
 	return out, nil
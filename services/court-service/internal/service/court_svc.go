@@ -24,8 +24,8 @@ func (s *CourtSvc) Create(ctx context.Context, in domain.Court) (*domain.Court,
 func (s *CourtSvc) Get(ctx context.Context, id string) (*domain.Court, error) {
 	return s.repo.ByID(ctx, id)
 }
-func (s *CourtSvc) List(ctx context.Context, page, size int32, venue string) ([]domain.Court, error) {
-	return s.repo.List(ctx, page, size, venue)
+func (s *CourtSvc) List(ctx context.Context, page, size int32, venue, ownerID string) ([]domain.Court, error) {
+	return s.repo.List(ctx, page, size, venue, ownerID)
 }
 func (s *CourtSvc) Update(ctx context.Context, in domain.Court) (*domain.Court, error) {
 	if err := s.repo.Update(ctx, &in); err != nil {
@@ -2,6 +2,11 @@ package grpc
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	courtv1 "github.com/you/badminton-booking/proto/court/v1"
 	"github.com/you/badminton-booking/services/court-service/internal/domain"
@@ -24,6 +29,7 @@ func (s *Server) CreateCourt(ctx context.Context, in *courtv1.CreateCourtRequest
 		PricePerHour: in.PricePerHour,
 		OpenFrom:     in.OpenFrom,
 		OpenTo:       in.OpenTo,
+		OwnerID:      in.OwnerId,
 	}
 	out, err := s.svc.Create(ctx, d)
 	if err != nil {
@@ -34,13 +40,16 @@ func (s *Server) CreateCourt(ctx context.Context, in *courtv1.CreateCourtRequest
 
 func (s *Server) GetCourt(ctx context.Context, in *courtv1.GetCourtRequest) (*courtv1.GetCourtResponse, error) {
 	c, err := s.svc.Get(ctx, in.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "court not found")
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &courtv1.GetCourtResponse{Court: toPB(c)}, nil
 }
 func (s *Server) ListCourts(ctx context.Context, in *courtv1.ListCourtsRequest) (*courtv1.ListCourtsResponse, error) {
-	list, err := s.svc.List(ctx, in.Page, in.PageSize, in.VenueQuery)
+	list, err := s.svc.List(ctx, in.Page, in.PageSize, in.VenueQuery, in.OwnerId)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func main() {
 		Exchange:    "",
 		Exchanges:   exchanges,
 		Queue:       mustEnv("NOTIFY_QUEUE", "notification.q"),
-		Bindings:    parseCSV(mustEnv("NOTIFY_BINDINGS", "booking.*,payment.*")),
+		Bindings:    parseCSV(mustEnv("NOTIFY_BINDINGS", "booking.*,payment.*,user.*")), // user.* = role เปลี่ยน (เช่น อนุมัติเป็น OWNER)
 		Prefetch:    16,
 		UseDLX:      true,
 		DLXName:     mustEnv("NOTIFY_DLX", "notification.dlx"),
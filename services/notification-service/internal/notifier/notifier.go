@@ -29,3 +29,7 @@ func HumanTimeRange(startUnix, endUnix int64) string {
 	et := time.Unix(endUnix, 0).Local()
 	return fmt.Sprintf("%s — %s", st.Format("2006-01-02 15:04"), et.Format("15:04"))
 }
+
+func HumanTime(unix int64) string {
+	return time.Unix(unix, 0).Local().Format("2006-01-02 15:04")
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -9,7 +10,7 @@ import (
 
 	amqp "github.com/rabbitmq/amqp091-go"
 
-	"github.com/you/badminton-booking/services/notification-service/internal/events"
+	"github.com/you/badminton-booking/pkg/events"
 	"github.com/you/badminton-booking/services/notification-service/internal/notifier"
 )
 
@@ -152,7 +153,11 @@ func (c *Consumer) Run(ctx context.Context) error {
 			if !ok {
 				return nil
 			}
-			if err := c.handleDelivery(d); err != nil {
+			if err := c.handleDelivery(d); errors.Is(err, errBadPayload) {
+				log.Printf("[notify] drop key=%s err=%v -> Nack", d.RoutingKey, err)
+				_ = d.Nack(false, false)
+				continue
+			} else if err != nil {
 				log.Printf("[notify] handle error key=%s err=%v -> Nack&requeue", d.RoutingKey, err)
 				_ = d.Nack(false, true)
 				continue
@@ -162,54 +167,148 @@ func (c *Consumer) Run(ctx context.Context) error {
 	}
 }
 
+// errBadPayload body อ่านไม่ได้ตามสัญญาใน pkg/events: requeue ไปก็ไม่หาย จึง Nack ทิ้ง (เข้า DLQ ถ้าเปิด DLX)
+var errBadPayload = errors.New("bad payload")
+
+func decode[T any](body []byte) (events.Envelope[T], error) {
+	ev, err := events.Decode[T](body)
+	if err != nil {
+		return ev, fmt.Errorf("%w: %v", errBadPayload, err)
+	}
+	return ev, nil
+}
+
 func (c *Consumer) handleDelivery(d amqp.Delivery) error {
 	key := d.RoutingKey
 	body := d.Body
 
 	switch key {
 	case events.RKBookingCreated:
-		ev, err := events.MustUnmarshal[events.BookingCreated](body)
+		ev, err := decode[events.BookingCreated](body)
 		if err != nil {
 			return err
 		}
 		return c.notifier.Notify("📅 Booking Created",
-			fmt.Sprintf("Booking %s (court=%s) %s", ev.BookingID, ev.CourtID, notifier.HumanTimeRange(ev.Start, ev.End)))
+			fmt.Sprintf("Booking %s (court=%s) %s", ev.Data.BookingID, ev.Data.CourtID, notifier.HumanTimeRange(ev.Data.Start, ev.Data.End)))
 
 	case events.RKBookingConfirmed:
-		ev, err := events.MustUnmarshal[events.BookingSimple](body)
+		ev, err := decode[events.BookingStatus](body)
 		if err != nil {
 			return err
 		}
 		return c.notifier.Notify("✅ Booking Confirmed",
-			fmt.Sprintf("Booking %s has been confirmed.", ev.BookingID))
+			fmt.Sprintf("Booking %s has been confirmed.", ev.Data.BookingID))
 
 	case events.RKBookingCancelled:
-		ev, err := events.MustUnmarshal[events.BookingSimple](body)
+		ev, err := decode[events.BookingCancelled](body)
 		if err != nil {
 			return err
 		}
+		if ev.Data.Reason != "" {
+			return c.notifier.Notify("❌ Booking Cancelled",
+				fmt.Sprintf("Booking %s has been cancelled (%s).", ev.Data.BookingID, ev.Data.Reason))
+		}
 		return c.notifier.Notify("❌ Booking Cancelled",
-			fmt.Sprintf("Booking %s has been cancelled.", ev.BookingID))
+			fmt.Sprintf("Booking %s has been cancelled.", ev.Data.BookingID))
+
+	case events.RKBookingExpired:
+		ev, err := decode[events.BookingStatus](body)
+		if err != nil {
+			return err
+		}
+		return c.notifier.Notify("⌛ Booking Expired",
+			fmt.Sprintf("Booking %s expired before payment; the slot has been released.", ev.Data.BookingID))
+
+	case events.RKBookingRescheduled:
+		ev, err := decode[events.BookingRescheduled](body)
+		if err != nil {
+			return err
+		}
+		return c.notifier.Notify("🔁 Booking Rescheduled",
+			fmt.Sprintf("Booking %s moved from %s (court=%s) to %s (court=%s)", ev.Data.BookingID,
+				notifier.HumanTimeRange(ev.Data.OldStart, ev.Data.OldEnd), ev.Data.OldCourtID,
+				notifier.HumanTimeRange(ev.Data.Start, ev.Data.End), ev.Data.CourtID))
+
+	case events.RKBookingSplit:
+		ev, err := decode[events.BookingSplit](body)
+		if err != nil {
+			return err
+		}
+		// แจ้งผู้ถูกเชิญทีละคนพร้อม share_id ที่ต้องใช้ตอนจ่าย
+		for _, sh := range ev.Data.Shares {
+			to := sh.Email
+			if to == "" {
+				to = "user " + sh.UserID
+			}
+			if err := c.notifier.Notify("🤝 Split Payment Invite",
+				fmt.Sprintf("%s: your share of booking %s %s is %d %s (share=%s); pay before %s.", to, ev.Data.BookingID,
+					notifier.HumanTimeRange(ev.Data.Start, ev.Data.End), sh.Amount, strings.ToUpper(ev.Data.Currency), sh.ShareID,
+					notifier.HumanTime(ev.Data.ExpiresAt))); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case events.RKBookingPaymentRejected:
+		ev, err := decode[events.PaymentRejected](body)
+		if err != nil {
+			return err
+		}
+		return c.notifier.Notify("↩️ Payment Not Applied",
+			fmt.Sprintf("Payment %s for booking %s arrived too late (%s); %d %s will be refunded.", ev.Data.PaymentID, ev.Data.BookingID,
+				ev.Data.Reason, ev.Data.Amount, strings.ToUpper(ev.Data.Currency)))
+
+	case events.RKBookingCompleted, events.RKBookingNoShow:
+		// ไม่ต้องแจ้งเตือน แค่ตรวจว่า payload ถูกสัญญา
+		_, err := decode[events.BookingStatus](body)
+		return err
 
 	case events.RKPaymentPaid:
-		ev, err := events.MustUnmarshal[events.PaymentPaid](body)
+		ev, err := decode[events.PaymentPaid](body)
 		if err != nil {
 			return err
 		}
 		return c.notifier.Notify("💰 Payment Paid",
-			fmt.Sprintf("Booking %s paid %d %s (charge=%s).", ev.BookingID, ev.Amount, strings.ToUpper(ev.Currency), ev.ChargeID))
+			fmt.Sprintf("Booking %s paid %d %s via %s (charge=%s).", ev.Data.BookingID, ev.Data.Amount, strings.ToUpper(ev.Data.Currency), ev.Data.Method, ev.Data.PaymentID))
 
 	case events.RKPaymentFailed:
-		ev, err := events.MustUnmarshal[events.PaymentFailed](body)
+		ev, err := decode[events.PaymentFailed](body)
 		if err != nil {
 			return err
 		}
-		msg := fmt.Sprintf("Payment failed for booking %s (charge=%s).", ev.BookingID, ev.ChargeID)
-		if ev.FailureCode != "" || ev.FailureMessage != "" {
-			msg = fmt.Sprintf("%s Reason: %s %s", msg, ev.FailureCode, ev.FailureMessage)
+		msg := fmt.Sprintf("Payment failed for booking %s (charge=%s).", ev.Data.BookingID, ev.Data.PaymentID)
+		if ev.Data.FailureCode != "" || ev.Data.FailureMessage != "" {
+			msg = fmt.Sprintf("%s Reason: %s %s", msg, ev.Data.FailureCode, ev.Data.FailureMessage)
 		}
 		return c.notifier.Notify("⚠️ Payment Failed", msg)
 
+	case events.RKPaymentRefunded:
+		ev, err := decode[events.PaymentRefunded](body)
+		if err != nil {
+			return err
+		}
+		return c.notifier.Notify("↩️ Payment Refunded",
+			fmt.Sprintf("Booking %s refunded %d %s (charge=%s).", ev.Data.BookingID, ev.Data.Amount, strings.ToUpper(ev.Data.Currency), ev.Data.PaymentID))
+
+	case events.RKUserRegistered:
+		ev, err := decode[events.UserRegistered](body)
+		if err != nil {
+			return err
+		}
+		return c.notifier.Notify("👋 Welcome",
+			fmt.Sprintf("%s registered as %s.", ev.Data.Email, ev.Data.Role))
+
+	case events.RKUserRoleChanged:
+		ev, err := decode[events.UserRoleChanged](body)
+		if err != nil {
+			return err
+		}
+		msg := fmt.Sprintf("%s: your role changed from %s to %s.", ev.Data.Email, ev.Data.OldRole, ev.Data.NewRole)
+		if ev.Data.Reason != "" {
+			msg = fmt.Sprintf("%s (%s)", msg, ev.Data.Reason)
+		}
+		return c.notifier.Notify("🔑 Role Changed", msg)
+
 	default:
 		// ไม่รู้จัก key — แค่บันทึกแล้วรับไว้ (หรือจะ Nack ก็ได้)
 		log.Printf("[notify] skip unknown key=%s", key)
@@ -1,34 +1,85 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
-	"github.com/omise/omise-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/you/badminton-booking/pkg/db"
+	"github.com/you/badminton-booking/pkg/events"
 	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/pkg/outbox"
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
 	paymentv1 "github.com/you/badminton-booking/proto/payment/v1"
 
+	"github.com/you/badminton-booking/services/payment-service/internal/consumer"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
 	httpx "github.com/you/badminton-booking/services/payment-service/internal/http"
-	omisecli "github.com/you/badminton-booking/services/payment-service/internal/omise"
+	"github.com/you/badminton-booking/services/payment-service/internal/provider"
+	"github.com/you/badminton-booking/services/payment-service/internal/receipt"
+	"github.com/you/badminton-booking/services/payment-service/internal/reconciler"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
 	paysvc "github.com/you/badminton-booking/services/payment-service/internal/service"
+	"github.com/you/badminton-booking/services/payment-service/internal/settlement"
 	tgrpc "github.com/you/badminton-booking/services/payment-service/internal/transport/grpc"
 )
 
 type Cfg struct {
+	PGPaymentDSN    string `envconfig:"PG_PAYMENT_DSN" required:"true"`
 	PaymentGRPCAddr string `envconfig:"PAYMENT_GRPC_ADDR" default:":50054"`
 	WebhookHTTPAddr string `envconfig:"PAYMENT_WEBHOOK_HTTP_ADDR" default:":8081"`
-	OmisePub        string `envconfig:"OMISE_PUBLIC_KEY" required:"true"`
-	OmiseSec        string `envconfig:"OMISE_SECRET_KEY" required:"true"`
+	OmisePub        string `envconfig:"OMISE_PUBLIC_KEY"` // จำเป็นเมื่อ PAYMENT_PROVIDER=omise
+	OmiseSec        string `envconfig:"OMISE_SECRET_KEY"`
 	OmiseVer        string `envconfig:"OMISE_API_VERSION" default:""`
 	RabbitURL       string `envconfig:"RABBIT_URL" required:"true"`
 	PaymentExchange string `envconfig:"PAYMENT_EXCHANGE" default:"payment.exchange"`
+	// ความถี่ที่ relay ดึง outbox ไป publish
+	OutboxInterval time.Duration `envconfig:"PAYMENT_OUTBOX_INTERVAL" default:"1s"`
+	// booking.cancelled -> คืนเงินตาม cancellation policy, booking.expired -> คืนส่วนที่จ่ายแล้วของ booking หารจ่าย
+	BookingExchange string `envconfig:"BOOKING_EXCHANGE" default:"booking.exchange"`
+	BookingQueue    string `envconfig:"PAYMENT_BOOKING_QUEUE" default:"payment.booking.q"`
+	BookingGRPCAddr string `envconfig:"BOOKING_GRPC_ADDR" default:":50053"`
+
+	// omise = Omise จริง, fake = gateway จำลองในหน่วยความจำ (ไม่ต้องมี key)
+	Provider string `envconfig:"PAYMENT_PROVIDER" default:"omise"`
+	// fake: URL ภายนอกของ webhook server (ใช้สร้าง authorize_uri/QR) และเวลาที่ PromptPay สำเร็จเอง
+	FakeBaseURL    string        `envconfig:"FAKE_GATEWAY_BASE_URL" default:"http://localhost:8081"`
+	FakeAsyncDelay time.Duration `envconfig:"FAKE_GATEWAY_ASYNC_DELAY" default:"10s"`
+
+	// webhook รับเฉพาะ path /webhooks/omise/<token> หรือจาก IP ใน allowlist (CIDR คั่นด้วย ,)
+	WebhookToken        string   `envconfig:"PAYMENT_WEBHOOK_TOKEN"`
+	WebhookAllowedCIDRs []string `envconfig:"PAYMENT_WEBHOOK_ALLOWED_CIDRS"`
+	WebhookWorkers      int      `envconfig:"PAYMENT_WEBHOOK_WORKERS" default:"4"`
+
+	// reconcile: เทียบ charge ย้อนหลัง lookback กับ ledger/booking ทุก interval
+	ReconcileInterval time.Duration `envconfig:"PAYMENT_RECONCILE_INTERVAL" default:"15m"`
+	ReconcileLookback time.Duration `envconfig:"PAYMENT_RECONCILE_LOOKBACK" default:"48h"`
+
+	// payout: ใบสรุปยอดของเจ้าของสนามต่องวด (weekly|monthly ตามเวลา PAYMENT_TZ) หัก commission ของแพลตฟอร์ม
+	CourtGRPCAddr     string        `envconfig:"COURT_GRPC_ADDR" default:":50052"`
+	CommissionPercent float64       `envconfig:"PAYMENT_COMMISSION_PERCENT" default:"10"`
+	PayoutPeriod      string        `envconfig:"PAYMENT_PAYOUT_PERIOD" default:"monthly"`
+	PayoutTZ          string        `envconfig:"PAYMENT_TZ" default:"Asia/Bangkok"`
+	SettleInterval    time.Duration `envconfig:"PAYMENT_SETTLEMENT_INTERVAL" default:"1h"`
+
+	// receipt: ออกใบเสร็จเมื่อ payment.paid; มี tax id = ใบกำกับภาษีอย่างย่อ (แยก VAT จากราคารวม VAT)
+	ReceiptQueue  string  `envconfig:"PAYMENT_RECEIPT_QUEUE" default:"payment.receipt.q"`
+	SellerName    string  `envconfig:"PAYMENT_SELLER_NAME" default:"Badminton Booking"`
+	SellerTaxID   string  `envconfig:"PAYMENT_SELLER_TAX_ID"`
+	SellerAddress string  `envconfig:"PAYMENT_SELLER_ADDRESS"`
+	VATPercent    float64 `envconfig:"PAYMENT_VAT_PERCENT" default:"7"`
+	ReceiptFont   string  `envconfig:"PAYMENT_RECEIPT_FONT"` // TTF ที่มีภาษาไทย (image ตั้งไว้ที่ /fonts/receipt.ttf); ว่าง = ไม่ออกใบเสร็จ
 }
 
 func must[T any](v T, err error) T {
@@ -42,26 +93,120 @@ func main() {
 	var cfg Cfg
 	must(0, envconfig.Process("", &cfg))
 
-	// Omise client
-	var omc *omise.Client = must(omisecli.NewOmiseClient(cfg.OmisePub, cfg.OmiseSec, cfg.OmiseVer))
+	// DB (ledger ของ charge)
+	gdb := db.Open(cfg.PGPaymentDSN)
+	repo := repository.NewPaymentRepo(gdb)
+	must(0, repo.Migrate())
+
+	// webhook: path ที่ตั้งให้ gateway ยิงมา (รวม token ถ้ามี)
+	webhookPath := "/webhooks/omise"
+	if cfg.WebhookToken != "" {
+		webhookPath += "/" + cfg.WebhookToken
+	}
+	var allowedNets []netip.Prefix
+	for _, c := range cfg.WebhookAllowedCIDRs {
+		allowedNets = append(allowedNets, must(netip.ParsePrefix(c)))
+	}
+	if cfg.WebhookToken == "change-me-webhook-token" {
+		// ค่าตัวอย่างเก่าของ .env.local: ใครก็เดา path ได้
+		log.Fatal("PAYMENT_WEBHOOK_TOKEN is the example value; set a random token (e.g. openssl rand -hex 32)")
+	}
+	if cfg.WebhookToken == "" && len(allowedNets) == 0 {
+		// webhook เปิดโล่ง = ใครก็ยิง event id ให้ worker ไปดึงซ้ำจาก gateway ได้; ยอมเฉพาะ gateway จำลอง
+		if cfg.Provider != "fake" {
+			log.Fatalf("PAYMENT_WEBHOOK_TOKEN or PAYMENT_WEBHOOK_ALLOWED_CIDRS is required for PAYMENT_PROVIDER=%s", cfg.Provider)
+		}
+		log.Println("[payment] WARNING: webhook accepts requests from anywhere (set PAYMENT_WEBHOOK_TOKEN or PAYMENT_WEBHOOK_ALLOWED_CIDRS)")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	// MQ publisher
+	// MQ publisher (relay ส่ง payment.* events จาก outbox)
 	pub := must(mq.NewPublisher(cfg.RabbitURL, cfg.PaymentExchange))
 	defer pub.Close()
+	go outbox.NewRelay(gdb, pub, cfg.OutboxInterval).Run(ctx)
 
-	// Start HTTP webhook (publish events)
+	// Payment provider
 	mux := http.NewServeMux()
-	mux.HandleFunc("/webhooks/omise", httpx.NewWebhookServer(omc, pub, cfg.PaymentExchange).Handler)
+	var prov provider.PaymentProvider
+	switch cfg.Provider {
+	case "omise":
+		if cfg.OmisePub == "" || cfg.OmiseSec == "" {
+			log.Fatal("OMISE_PUBLIC_KEY and OMISE_SECRET_KEY are required for PAYMENT_PROVIDER=omise")
+		}
+		prov = must(provider.NewOmise(cfg.OmisePub, cfg.OmiseSec, cfg.OmiseVer))
+	case "fake":
+		fake := provider.NewFake(cfg.FakeBaseURL, cfg.FakeBaseURL+webhookPath, cfg.FakeAsyncDelay)
+		mux.Handle("/fake/", fake.Handler())
+		prov = fake
+		log.Println("[payment] using FAKE payment gateway")
+	default:
+		log.Fatalf("unknown PAYMENT_PROVIDER %q (omise|fake)", cfg.Provider)
+	}
+
+	// booking-service client (ตรวจยอดกับราคาของ booking)
+	bookingConn := must(grpc.NewClient(cfg.BookingGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer bookingConn.Close()
+	bookingClient := bookingv1.NewBookingServiceClient(bookingConn)
+	svc := paysvc.NewPaymentSvc(prov, bookingClient, repo)
+
+	// court-service client (หาเจ้าของสนามของ booking ตอนออกใบสรุป)
+	courtConn := must(grpc.NewClient(cfg.CourtGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer courtConn.Close()
+	courtClient := courtv1.NewCourtServiceClient(courtConn)
+	payouts := paysvc.NewPayoutSvc(repo, bookingClient, courtClient, cfg.CommissionPercent)
+
+	loc := must(time.LoadLocation(cfg.PayoutTZ))
+	var render func(*domain.Receipt) ([]byte, error)
+	if cfg.ReceiptFont != "" {
+		render = must(receipt.NewRenderer(cfg.ReceiptFont, loc)).Render
+	} else {
+		log.Println("[payment] WARNING: PAYMENT_RECEIPT_FONT is not set; receipts are disabled")
+	}
+	receipts := paysvc.NewReceiptSvc(repo, bookingClient, courtClient, render, paysvc.Seller{
+		Name:       cfg.SellerName,
+		TaxID:      cfg.SellerTaxID,
+		Address:    cfg.SellerAddress,
+		VATPercent: cfg.VATPercent,
+	}, loc)
+
+	// Start HTTP webhook (ตอบทันที; worker ยืนยันกับ gateway แล้วบันทึก ledger + events ลง outbox)
+	webhook := httpx.NewWebhookServer(prov, svc, repo, httpx.WebhookOptions{
+		Token:       cfg.WebhookToken,
+		AllowedNets: allowedNets,
+		Workers:     cfg.WebhookWorkers,
+	})
+	go webhook.Run(ctx)
+	mux.HandleFunc("/webhooks/omise", webhook.Handler)
+	mux.HandleFunc("/webhooks/omise/", webhook.Handler)
 	go func() {
 		log.Println("[payment] webhook http listening on", cfg.WebhookHTTPAddr)
 		log.Fatal(http.ListenAndServe(cfg.WebhookHTTPAddr, mux))
 	}()
 
+	// Consumer (ฟัง booking.cancelled / booking.expired เพื่อคืนเงิน)
+	bookingCons := must(mq.NewConsumer(cfg.RabbitURL, cfg.BookingExchange, cfg.BookingQueue, []string{events.RKBookingCancelled, events.RKBookingExpired, events.RKBookingPaymentRejected}))
+	defer bookingCons.Close()
+	must(0, consumer.NewBookingConsumer(svc, bookingCons).Run(ctx))
+	log.Println("[payment] consumer started (booking.cancelled, booking.expired, booking.payment_rejected)")
+
+	// Consumer (ฟัง payment.paid ของตัวเองเพื่อออกใบเสร็จ)
+	receiptCons := must(mq.NewConsumer(cfg.RabbitURL, cfg.PaymentExchange, cfg.ReceiptQueue, []string{events.RKPaymentPaid}))
+	defer receiptCons.Close()
+	must(0, consumer.NewReceiptConsumer(receipts, receiptCons).Run(ctx))
+	log.Println("[payment] consumer started (payment.paid -> receipt)")
+
+	// Reconciler (ตามเก็บ payment.paid/failed ที่ webhook หาย + รายงานความคลาดเคลื่อน)
+	go reconciler.New(svc, cfg.ReconcileInterval, cfg.ReconcileLookback).Run(ctx)
+
+	// Settlement (ออกใบสรุปของงวดที่เพิ่งปิด)
+	go settlement.New(payouts, cfg.PayoutPeriod, loc, cfg.SettleInterval).Run(ctx)
+
 	// gRPC server (สำหรับสร้าง charge ผ่าน gateway ถ้าคุณมี proto)
-	svc := paysvc.NewPaymentSvc(omc, cfg.OmiseSec, pub)
 	lis := must(net.Listen("tcp", cfg.PaymentGRPCAddr))
 	gs := grpc.NewServer()
-	paymentv1.RegisterPaymentServiceServer(gs, tgrpc.NewServer(svc))
+	paymentv1.RegisterPaymentServiceServer(gs, tgrpc.NewServer(svc, payouts, receipts))
 	log.Println("[payment] gRPC listening on", cfg.PaymentGRPCAddr)
 
 	// graceful
@@ -69,6 +214,7 @@ func main() {
 		ch := make(chan os.Signal, 1)
 		signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
 		<-ch
+		cancel()
 		gs.GracefulStop()
 	}()
 
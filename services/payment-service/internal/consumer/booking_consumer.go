@@ -0,0 +1,131 @@
+package consumer
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/services/payment-service/internal/service"
+)
+
+// BookingConsumer คืนเงินตามยอดที่ booking-service คิดจาก cancellation policy (booking.cancelled)
+// คืนส่วนที่จ่ายแล้วของ booking หารจ่ายที่หมดเวลา (booking.expired)
+// และคืนเต็มจำนวนให้ charge ที่ booking รับไม่ได้แล้ว (booking.payment_rejected)
+type BookingConsumer struct {
+	svc  *service.PaymentSvc
+	cons *mq.Consumer
+}
+
+func NewBookingConsumer(svc *service.PaymentSvc, cons *mq.Consumer) *BookingConsumer {
+	return &BookingConsumer{svc: svc, cons: cons}
+}
+
+func (bc *BookingConsumer) Run(ctx context.Context) error {
+	msgs, err := bc.cons.Deliveries(ctx)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for d := range msgs {
+			var (
+				ectx      = ctx
+				bookingID string
+				refunds   []service.RefundInput
+			)
+			switch d.RoutingKey {
+			case events.RKBookingCancelled:
+				evt, err := events.Decode[events.BookingCancelled](d.Body)
+				if err != nil {
+					log.Printf("[payment-consumer] %v", err)
+					_ = d.Nack(false, false)
+					continue
+				}
+				ectx, bookingID = evt.Context(ctx), evt.Data.BookingID
+				reason := "booking cancelled"
+				if evt.Data.Reason != "" {
+					reason += ": " + evt.Data.Reason
+				}
+				if evt.Data.PaymentID != "" && len(evt.Data.Refunds) == 0 {
+					refunds = append(refunds, service.RefundInput{
+						ChargeID: evt.Data.PaymentID,
+						Amount:   evt.Data.RefundAmount,
+						Reason:   reason,
+						Key:      events.RKBookingCancelled + ":" + bookingID,
+					})
+				}
+				refunds = append(refunds, shareRefunds(events.RKBookingCancelled, bookingID, reason, evt.Data.Refunds)...)
+			case events.RKBookingExpired:
+				// booking หารจ่ายที่จ่ายไม่ครบก่อนหมดเวลา: คืนเต็มทุกส่วนที่จ่ายแล้ว
+				evt, err := events.Decode[events.BookingStatus](d.Body)
+				if err != nil {
+					log.Printf("[payment-consumer] %v", err)
+					_ = d.Nack(false, false)
+					continue
+				}
+				ectx, bookingID = evt.Context(ctx), evt.Data.BookingID
+				refunds = shareRefunds(events.RKBookingExpired, bookingID, "booking expired before all shares were paid", evt.Data.Refunds)
+			case events.RKBookingPaymentRejected:
+				// เงินเข้ามาหลัง booking ยกเลิก/หมดเวลา: คืนทั้ง charge (กันซ้ำต่อ charge)
+				evt, err := events.Decode[events.PaymentRejected](d.Body)
+				if err != nil {
+					log.Printf("[payment-consumer] %v", err)
+					_ = d.Nack(false, false)
+					continue
+				}
+				ectx, bookingID = evt.Context(ctx), evt.Data.BookingID
+				refunds = append(refunds, service.RefundInput{
+					ChargeID: evt.Data.PaymentID,
+					Amount:   evt.Data.Amount,
+					Reason:   "payment not applied to booking: " + evt.Data.Reason,
+					Key:      service.RejectedRefundKey(evt.Data.PaymentID),
+				})
+			}
+			if !bc.refundAll(ectx, bookingID, refunds) {
+				_ = d.Nack(false, true)
+				continue
+			}
+			_ = d.Ack(false)
+		}
+	}()
+	return nil
+}
+
+// shareRefunds รายการคืนเงินของ booking หารจ่าย: คนละ charge จึงกันซ้ำด้วย key ต่อ charge
+func shareRefunds(rk, bookingID, reason string, refunds []events.Refund) []service.RefundInput {
+	out := make([]service.RefundInput, 0, len(refunds))
+	for _, rf := range refunds {
+		out = append(out, service.RefundInput{
+			ChargeID: rf.PaymentID,
+			Amount:   rf.Amount,
+			Reason:   reason,
+			Key:      rk + ":" + bookingID + ":" + rf.PaymentID,
+		})
+	}
+	return out
+}
+
+// refundAll คืนเงินทุกรายการ (ไม่ได้จ่าย/policy ไม่คืน = ไม่มีรายการหรือยอดเป็น 0)
+// false = มีรายการที่ควรลองใหม่; รายการที่คืนไปแล้วถูกกันซ้ำด้วย Key
+func (bc *BookingConsumer) refundAll(ctx context.Context, bookingID string, refunds []service.RefundInput) bool {
+	ok := true
+	for _, in := range refunds {
+		if in.ChargeID == "" || in.Amount <= 0 {
+			continue
+		}
+		_, err := bc.svc.Refund(ctx, in)
+		if status.Code(err) == codes.FailedPrecondition || status.Code(err) == codes.InvalidArgument {
+			// คืนไม่ได้แล้ว (เช่น ถูกคืนมือไปก่อน) ลองใหม่ก็ไม่ผ่าน
+			log.Printf("[payment-consumer] refund of %s for booking %s skipped: %v", in.ChargeID, bookingID, err)
+			continue
+		}
+		if err != nil {
+			log.Printf("[payment-consumer] refund of %s for booking %s error: %v", in.ChargeID, bookingID, err)
+			ok = false
+		}
+	}
+	return ok
+}
@@ -0,0 +1,65 @@
+package consumer
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/services/payment-service/internal/service"
+)
+
+// ReceiptConsumer ออกใบเสร็จเมื่อจ่ายสำเร็จ (payment.paid); event ซ้ำได้ใบเดิม
+type ReceiptConsumer struct {
+	svc  *service.ReceiptSvc
+	cons *mq.Consumer
+}
+
+func NewReceiptConsumer(svc *service.ReceiptSvc, cons *mq.Consumer) *ReceiptConsumer {
+	return &ReceiptConsumer{svc: svc, cons: cons}
+}
+
+func (rc *ReceiptConsumer) Run(ctx context.Context) error {
+	msgs, err := rc.cons.Deliveries(ctx)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for d := range msgs {
+			if d.RoutingKey != events.RKPaymentPaid {
+				_ = d.Ack(false)
+				continue
+			}
+			evt, err := events.Decode[events.PaymentPaid](d.Body)
+			if err != nil {
+				log.Printf("[receipt-consumer] %v", err)
+				_ = d.Nack(false, false)
+				continue
+			}
+			if evt.Data.PaymentID == "" {
+				log.Printf("[receipt-consumer] invalid event payload")
+				_ = d.Ack(false)
+				continue
+			}
+			ctx := evt.Context(ctx)
+			r, err := rc.svc.Issue(ctx, evt.Data.PaymentID)
+			if code := status.Code(err); code == codes.NotFound || code == codes.FailedPrecondition {
+				// ไม่มีใน ledger / ไม่ได้สำเร็จ ลองใหม่ก็ไม่ผ่าน
+				log.Printf("[receipt-consumer] receipt for %s skipped: %v", evt.Data.PaymentID, err)
+				_ = d.Ack(false)
+				continue
+			}
+			if err != nil {
+				log.Printf("[receipt-consumer] receipt for %s error: %v", evt.Data.PaymentID, err)
+				_ = d.Nack(false, true)
+				continue
+			}
+			log.Printf("[receipt-consumer] receipt %s for charge %s", r.Number, r.ChargeID)
+			_ = d.Ack(false)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,237 @@
+package domain
+
+import "time"
+
+// Payment หนึ่งแถวต่อหนึ่ง charge ของ gateway; สถานะอัปเดตตามผลตอนสร้างและจาก webhook
+type Payment struct {
+	ChargeID       string `gorm:"primaryKey"`
+	BookingID      string `gorm:"index"`
+	ShareID        string `gorm:"index"` // ส่วนของ booking หารจ่ายที่ charge นี้จ่าย (ว่าง = ทั้ง booking)
+	UserID         string `gorm:"index"` // เจ้าของ booking (ว่างได้ถ้า booking-service ตอบไม่ได้ตอนสร้าง)
+	Amount         int64  // หน่วยย่อย (สตางค์)
+	Currency       string
+	Method         string // card / promptpay / internet_banking_* ...
+	Status         string `gorm:"index"` // pending / successful / failed / expired / reversed
+	RefundedAmount int64  // ยอดที่คืนไปแล้วรวมทุกครั้ง
+	FailureCode    string
+	FailureMessage string
+	Raw            string     `gorm:"type:jsonb"` // charge object ล่าสุดจาก gateway
+	PaidAt         *time.Time // ครั้งแรกที่เห็นสถานะ successful; ไม่เปลี่ยนอีก (UpdatedAt ขยับทุกครั้งที่ refresh)
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// สถานะของ Refund
+const (
+	RefundPending   = "pending" // จองไว้ก่อนเรียก gateway; ยังไม่รู้ว่าคืนสำเร็จหรือไม่
+	RefundSucceeded = "succeeded"
+)
+
+// Refund การคืนเงินหนึ่งครั้งของ charge (คืนบางส่วนได้หลายครั้ง)
+// แถวถูกจอง (pending) ด้วย Key ก่อนเรียก gateway แล้วค่อยปิดเป็น succeeded: คำขอซ้ำ/คำขอที่รับช่วงไม่คืนซ้ำ
+type Refund struct {
+	ID        string `gorm:"primaryKey"` // refund id ของ gateway (แถว pending = "pending:"+Key ชั่วคราว)
+	ChargeID  string `gorm:"index"`
+	BookingID string `gorm:"index"`
+	Amount    int64
+	Currency  string
+	Reason    string
+	// Key กันคืนซ้ำเมื่อคำขอเดิมถูกส่งมาอีก (เช่น booking.cancelled ถูก redeliver); ไม่ระบุ = สุ่มให้
+	// ส่งไปกับ gateway เป็น metadata refund_key ใช้หา refund ที่คืนไปแล้วตอนรับช่วงแถว pending
+	Key       string `gorm:"uniqueIndex"`
+	Status    string `gorm:"index;default:succeeded"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IdempotencyKey ผูก Idempotency-Key ของ client กับ charge ที่สร้างจากคำขอนั้น
+// ChargeID ว่าง = คำขอแรกยังทำอยู่ (หรือพังกลางทาง; ค้างนานเกินไปคำขอใหม่รับช่วงได้)
+type IdempotencyKey struct {
+	Key       string `gorm:"primaryKey"` // <user id>:<key> เมื่อรู้ตัวผู้เรียก (key ของคนละคนไม่ชนกัน)
+	BookingID string
+	ChargeID  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PaymentLock ให้การจ่ายของ booking/ส่วนหนึ่งทำได้ทีละคำขอ (ตรวจว่ายังไม่จ่าย -> สร้าง charge -> บันทึก ledger)
+// ผู้ถือที่ตายกลางทางไม่ค้าง lock: หมดอายุเองที่ ExpiresAt
+type PaymentLock struct {
+	Target    string `gorm:"primaryKey"` // booking:<id> หรือ share:<id>
+	Token     string
+	ExpiresAt time.Time
+}
+
+// สถานะของ WebhookEvent
+const (
+	WebhookPending    = "pending"    // รอ worker (รวมถึงรอ retry)
+	WebhookProcessing = "processing" // worker กำลังยืนยัน/ประมวลผล
+	WebhookDone       = "done"
+	WebhookFailed     = "failed" // retry ครบแล้วไม่สำเร็จ
+)
+
+// WebhookEvent event id ที่ webhook รับมาแล้ว (กันซ้ำเมื่อ gateway ส่งซ้ำ) และคิวงานของ worker
+type WebhookEvent struct {
+	ID            string `gorm:"primaryKey"` // event id ของ gateway (evnt_xxx)
+	Key           string // charge.complete ฯลฯ (รู้หลังยืนยันกับ gateway)
+	Status        string `gorm:"index"`
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ประเภทความคลาดเคลื่อนที่ reconcile เจอ
+const (
+	DiscMissingInLedger    = "missing_in_ledger"     // gateway มี charge แต่ ledger ไม่มี
+	DiscMissingAtProvider  = "missing_at_provider"   // ledger มีแต่ gateway ไม่พบในช่วงเดียวกัน
+	DiscStatusMismatch     = "status_mismatch"       // สถานะใน ledger ไม่ตรงกับ gateway
+	DiscAmountMismatch     = "amount_mismatch"       // ยอด/ยอดคืน ใน ledger ไม่ตรงกับ gateway
+	DiscBookingNotPaid     = "booking_not_confirmed" // จ่ายสำเร็จแต่ booking ยัง PENDING
+	DiscFailureNotReported = "failure_not_reported"  // charge ล้มเหลวแต่ booking ไม่เคยรู้
+	DiscPaidInactive       = "paid_booking_inactive" // จ่ายสำเร็จแต่ booking ถูกยกเลิก/หมดเวลาไปแล้ว และยังไม่คืนเงิน
+	DiscDuplicatePayment   = "duplicate_payment"     // booking CONFIRMED ด้วย charge อื่นอยู่แล้ว
+	DiscTopUpNotCredited   = "topup_not_credited"    // เติม wallet สำเร็จที่ gateway แต่ยอดยังไม่เข้า wallet
+)
+
+// ReconciliationRun ผล reconcile หนึ่งรอบ: charge ที่ gateway ในช่วง [From, To) เทียบกับ ledger และ booking
+type ReconciliationRun struct {
+	ID             string    `gorm:"primaryKey"`
+	From           time.Time // ช่วงของ charge ที่ตรวจ
+	To             time.Time
+	ChargesChecked int
+	Error          string    // list จาก gateway ไม่สำเร็จ (รอบนี้ไม่ได้ตรวจ)
+	StartedAt      time.Time `gorm:"index"`
+	FinishedAt     time.Time
+	Items          []ReconciliationItem `gorm:"foreignKey:RunID"`
+}
+
+// ReconciliationItem ความคลาดเคลื่อนหนึ่งรายการ พร้อมสิ่งที่ระบบแก้ไปแล้ว (ถ้ามี)
+type ReconciliationItem struct {
+	ID        uint   `gorm:"primaryKey"`
+	RunID     string `gorm:"index"`
+	ChargeID  string `gorm:"index"`
+	BookingID string
+	Kind      string // Disc*
+	Detail    string
+	Action    string // เช่น recorded, published payment.paid; ว่าง = ต้องให้คนตรวจ
+	CreatedAt time.Time
+}
+
+// สถานะใบสรุปยอดของเจ้าของสนาม
+const (
+	PayoutPending = "PENDING" // ออกใบแล้ว รอโอน
+	PayoutPaid    = "PAID"
+)
+
+// Payout ใบสรุปยอดที่ต้องโอนให้เจ้าของสนามหนึ่งคนในงวด [PeriodStart, PeriodEnd)
+// Net = Gross - Refunds - Commission (ติดลบได้ถ้างวดนั้นคืนเงินมากกว่ายอดขาย)
+type Payout struct {
+	ID                string    `gorm:"primaryKey"`
+	OwnerID           string    `gorm:"uniqueIndex:ux_payout_owner_period"`
+	PeriodStart       time.Time `gorm:"uniqueIndex:ux_payout_owner_period"`
+	PeriodEnd         time.Time
+	Currency          string `gorm:"uniqueIndex:ux_payout_owner_period"`
+	Gross             int64  // สตางค์
+	Refunds           int64
+	CommissionPercent float64 // ค่าที่ใช้ตอนออกใบ
+	Commission        int64
+	Net               int64
+	Status            string `gorm:"index"` // Payout*
+	PaidAt            *time.Time
+	PaidRef           string // เลขอ้างอิงการโอน
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Lines             []PayoutLine `gorm:"foreignKey:PayoutID"`
+}
+
+// ประเภทของ PayoutLine
+const (
+	PayoutLineCharge = "charge"
+	PayoutLineRefund = "refund"
+)
+
+// PayoutLine charge หรือ refund หนึ่งรายการในใบสรุป; (Kind, RefID) ถูกนับได้ครั้งเดียว
+type PayoutLine struct {
+	ID         uint   `gorm:"primaryKey"`
+	PayoutID   string `gorm:"index"`
+	Kind       string `gorm:"uniqueIndex:ux_payout_line_ref"` // PayoutLine*
+	RefID      string `gorm:"uniqueIndex:ux_payout_line_ref"` // charge id หรือ refund id
+	ChargeID   string
+	BookingID  string
+	CourtID    string
+	Amount     int64 // สตางค์ (เป็นบวกทั้ง charge และ refund)
+	OccurredAt time.Time
+}
+
+// Wallet ยอดเงินเติมล่วงหน้าของผู้ใช้ ใช้จ่ายค่า booking แทนบัตร/QR
+type Wallet struct {
+	UserID    string `gorm:"primaryKey"`
+	Balance   int64  // สตางค์
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ประเภทรายการของ wallet
+const (
+	WalletTopUp   = "TOPUP"   // เติมเงินผ่าน Omise charge
+	WalletPayment = "PAYMENT" // จ่ายค่า booking
+	WalletRefund  = "REFUND"  // คืนเงินของ booking ที่จ่ายด้วย wallet
+)
+
+// MethodWallet Payment.Method ของ booking ที่จ่ายด้วย wallet (ChargeID = id ของ WalletTransaction)
+const MethodWallet = "wallet"
+
+// WalletTransaction รายการเคลื่อนไหวของ wallet; Amount บวก = เงินเข้า, ลบ = เงินออก
+type WalletTransaction struct {
+	ID           string `gorm:"primaryKey"`
+	UserID       string `gorm:"index"`
+	Kind         string // Wallet*
+	Amount       int64
+	BalanceAfter int64
+	Currency     string
+	ChargeID     string `gorm:"index"` // TOPUP: Omise charge; PAYMENT/REFUND: payment id ของ wallet
+	BookingID    string
+	Key          string `gorm:"uniqueIndex"` // กันบันทึกซ้ำ เช่น topup:<charge id>, pay:<booking id>
+	CreatedAt    time.Time
+}
+
+// ประเภทเอกสารของ Receipt
+const (
+	ReceiptPlain      = "RECEIPT"     // ใบเสร็จรับเงิน (ผู้ขายไม่ได้จด VAT)
+	ReceiptTaxInvoice = "TAX_INVOICE" // ใบเสร็จรับเงิน/ใบกำกับภาษีอย่างย่อ
+)
+
+// Receipt ใบเสร็จของ payment ที่สำเร็จ หนึ่งใบต่อ charge; PDF ถูกสร้างครั้งเดียวตอนออกเลข
+type Receipt struct {
+	ID          string `gorm:"primaryKey"`
+	Number      string `gorm:"uniqueIndex"` // เช่น RC2026-000123 (เรียงต่อเนื่องต่อปี)
+	Kind        string // Receipt*
+	ChargeID    string `gorm:"uniqueIndex"`
+	BookingID   string
+	UserID      string `gorm:"index"`
+	CourtID     string
+	Venue       string
+	CourtNo     int32
+	StartTime   time.Time
+	EndTime     time.Time
+	Amount      int64   // รวม VAT (สตางค์)
+	VATPercent  float64 // 0 = ไม่มี VAT
+	VATAmount   int64
+	Currency    string
+	Method      string
+	SellerName  string
+	SellerTaxID string
+	SellerAddr  string
+	PaidAt      time.Time
+	PDF         []byte `gorm:"type:bytea"`
+	CreatedAt   time.Time
+}
+
+// ReceiptSequence เลขล่าสุดของแต่ละชุด (prefix+ปี) ใช้ lock แถวตอนออกเลข
+type ReceiptSequence struct {
+	Series string `gorm:"primaryKey"`
+	Last   int64
+}
@@ -3,31 +3,64 @@ package httpx
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/omise/omise-go"
-	"github.com/omise/omise-go/operations"
-	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/services/payment-service/internal/provider"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
+	"github.com/you/badminton-booking/services/payment-service/internal/service"
 )
 
+// WebhookOptions ค่า 0 ใช้ค่า default
+type WebhookOptions struct {
+	// Token shared secret ท้าย path (/webhooks/omise/<token>)
+	Token string
+	// AllowedNets รับจาก IP ในรายการนี้ได้โดยไม่ต้องมี token
+	// ไม่ตั้งทั้ง Token และ AllowedNets = รับทุกที่ (ใช้ตอน dev เท่านั้น)
+	AllowedNets []netip.Prefix
+	Workers     int           // default 4
+	MaxAttempts int           // default 8 แล้วเป็น failed
+	Interval    time.Duration // รอบหา event ที่ถึงเวลา retry, default 5s
+	Retention   time.Duration // เก็บ event id ที่ทำเสร็จไว้กันซ้ำ, default 30 วัน
+}
+
+// WebhookServer รับ webhook แล้วตอบ 200 ทันที; การยืนยันกับ gateway และ publish ทำใน worker
+// event id ถูกบันทึกใน webhook_events ทำให้ event ที่ gateway ส่งซ้ำไม่ถูกประมวลผลอีก
 type WebhookServer struct {
-	omc           *omise.Client
-	publisher     *mq.Publisher
-	Exchange      string
-	RoutingPaid   string
-	RoutingFailed string
+	prov provider.PaymentProvider
+	svc  *service.PaymentSvc // บันทึกสถานะ charge ลง ledger พร้อม payment.* (outbox)
+	repo *repository.PaymentRepo
+	opts WebhookOptions
+	jobs chan string
 }
 
-func NewWebhookServer(omc *omise.Client, pub *mq.Publisher, exchange string) *WebhookServer {
+func NewWebhookServer(prov provider.PaymentProvider, svc *service.PaymentSvc, repo *repository.PaymentRepo, opts WebhookOptions) *WebhookServer {
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 8
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Second
+	}
+	if opts.Retention <= 0 {
+		opts.Retention = 30 * 24 * time.Hour
+	}
 	return &WebhookServer{
-		omc:           omc,
-		publisher:     pub,
-		Exchange:      exchange,
-		RoutingPaid:   "payment.paid",
-		RoutingFailed: "payment.failed",
+		prov: prov,
+		svc:  svc,
+		repo: repo,
+		opts: opts,
+		jobs: make(chan string, 256),
 	}
 }
 
@@ -37,113 +70,196 @@ type incomingEvent struct {
 	Data json.RawMessage `json:"data"`
 }
 
-type PaymentPaid struct {
-	Event      string `json:"event"`       // "payment.paid"
-	Version    int    `json:"version"`     // 1
-	OccurredAt string `json:"occurred_at"` // RFC3339
-	Data       struct {
-		PaymentID string `json:"payment_id"`
-		BookingID string `json:"booking_id"`
-		Amount    int64  `json:"amount"`
-		Currency  string `json:"currency"`
-		Method    string `json:"method"`
-		IdemKey   string `json:"idempotency_key"`
-	} `json:"data"`
-}
-
-type PaymentFailed struct {
-	Event      string `json:"event"` // "payment.failed"
-	Version    int    `json:"version"`
-	OccurredAt string `json:"occurred_at"`
-	Data       struct {
-		PaymentID string `json:"payment_id"`
-		BookingID string `json:"booking_id"`
-		Reason    string `json:"reason"`
-	} `json:"data"`
-}
-
+// Handler ผูกกับทั้ง /webhooks/omise และ /webhooks/omise/<token>
 func (s *WebhookServer) Handler(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.allowed(r) {
+		log.Printf("[webhook] rejected request from %s", r.RemoteAddr)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
 
 	var inc incomingEvent
-	if err := json.NewDecoder(r.Body).Decode(&inc); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&inc); err != nil || inc.ID == "" {
 		http.Error(w, "bad request", http.StatusBadRequest)
 		return
 	}
 
-	// 1) ยืนยันเหตุการณ์กับ Omise โดยดึง Event อีกรอบ
-	ev := &omise.Event{}
-	if err := s.omc.Do(ev, &operations.RetrieveEvent{EventID: inc.ID}); err != nil {
-		log.Printf("[webhook] retrieve event error: %v", err)
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	// body ใช้แค่ id; ข้อมูลจริงดึงจาก gateway ใน worker
+	created, err := s.repo.SaveWebhookEvent(r.Context(), inc.ID)
+	if err != nil {
+		log.Printf("[webhook] save event %s error: %v", inc.ID, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if created {
+		s.enqueue(inc.ID)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// allowed: path token ตรง หรือ IP อยู่ใน allowlist
+func (s *WebhookServer) allowed(r *http.Request) bool {
+	if s.opts.Token == "" && len(s.opts.AllowedNets) == 0 {
+		return true
+	}
+	if s.opts.Token != "" {
+		token := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/webhooks/omise"), "/")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.opts.Token)) == 1 {
+			return true
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	for _, n := range s.opts.AllowedNets {
+		if n.Contains(ip.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue ไม่ block: คิวเต็มก็ปล่อยไว้ รอบ sweep จะหยิบจาก DB มาให้เอง
+func (s *WebhookServer) enqueue(id string) {
+	select {
+	case s.jobs <- id:
+	default:
+	}
+}
+
+// Run เริ่ม worker และรอบ sweep (event ที่ถึงเวลา retry / หลุดคิว / ค้าง processing) จนกว่า ctx จะถูก cancel
+func (s *WebhookServer) Run(ctx context.Context) {
+	for i := 0; i < s.opts.Workers; i++ {
+		go s.worker(ctx)
+	}
+	t := time.NewTicker(s.opts.Interval)
+	defer t.Stop()
+	for {
+		s.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *WebhookServer) sweep(ctx context.Context) {
+	now := time.Now().UTC()
+	if err := s.repo.ResetStuckWebhookEvents(ctx, now.Add(-2*time.Minute)); err != nil {
+		log.Printf("[webhook] reset stuck events error: %v", err)
+	}
+	ids, err := s.repo.DueWebhookEvents(ctx, now, cap(s.jobs))
+	if err != nil {
+		log.Printf("[webhook] load due events error: %v", err)
+		return
+	}
+	for _, id := range ids {
+		s.enqueue(id)
+	}
+	if err := s.repo.PruneWebhookEvents(ctx, now.Add(-s.opts.Retention)); err != nil {
+		log.Printf("[webhook] prune error: %v", err)
+	}
+}
+
+func (s *WebhookServer) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-s.jobs:
+			s.handle(ctx, id)
+		}
+	}
+}
+
+func (s *WebhookServer) handle(ctx context.Context, id string) {
+	ev, err := s.repo.ClaimWebhookEvent(ctx, id)
+	if err != nil {
+		log.Printf("[webhook] claim event %s error: %v", id, err)
 		return
 	}
+	if ev == nil {
+		return // worker อื่นได้ไปแล้ว หรือยังไม่ถึงเวลา retry
+	}
 
-	log.Printf("ev.Key: %s", ev.Key)
+	key, err := s.process(ctx, id)
+	if err == nil {
+		if err := s.repo.FinishWebhookEvent(ctx, id, key); err != nil {
+			log.Printf("[webhook] finish event %s error: %v", id, err)
+		}
+		return
+	}
+
+	var next time.Time
+	if ev.Attempts < s.opts.MaxAttempts {
+		next = time.Now().UTC().Add(backoff(ev.Attempts))
+	}
+	log.Printf("[webhook] event %s attempt %d/%d failed: %v", id, ev.Attempts, s.opts.MaxAttempts, err)
+	if err := s.repo.RetryWebhookEvent(ctx, id, err, next); err != nil {
+		log.Printf("[webhook] reschedule event %s error: %v", id, err)
+	}
+}
+
+// backoff 5s, 10s, 20s, ... สูงสุด 10 นาที
+func backoff(attempt int) time.Duration {
+	d := 5 * time.Second << (attempt - 1)
+	if d <= 0 || d > 10*time.Minute {
+		return 10 * time.Minute
+	}
+	return d
+}
+
+// process ยืนยันเหตุการณ์กับ gateway (ดึง Event อีกรอบแทนการเชื่อ body) แล้วบันทึก/publish
+// error = ให้ retry (การบันทึก ledger และ consumer ของ payment.* ทนการทำซ้ำได้)
+func (s *WebhookServer) process(ctx context.Context, id string) (key string, err error) {
+	ev, err := s.prov.RetrieveEvent(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("retrieve event: %w", err)
+	}
 
 	switch ev.Key {
 	case "charge.complete":
 		// ev.Data เป็น interface{} → marshal ก่อนแล้วค่อย unmarshal เป็น Charge
 		raw, err := json.Marshal(ev.Data)
 		if err != nil {
-			log.Printf("[webhook] marshal ev.Data error: %v", err)
-			w.WriteHeader(http.StatusOK)
-			return
+			return ev.Key, fmt.Errorf("marshal ev.Data: %w", err)
 		}
 		var ch omise.Charge
 		if err := json.Unmarshal(raw, &ch); err != nil {
-			log.Printf("[webhook] unmarshal charge error: %v", err)
-			w.WriteHeader(http.StatusOK)
-			return
+			return ev.Key, fmt.Errorf("unmarshal charge: %w", err)
 		}
 
-		bookingID, _ := ch.Metadata["booking_id"].(string)
-
-		if ch.Status == "successful" {
-			evt := PaymentPaid{
-				Event:      "payment.paid",
-				Version:    1,
-				OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		// charge เติม wallet ไม่เกี่ยวกับ booking: เติมยอดอย่างเดียว ไม่มี payment.*
+		if service.WalletUserOf(&ch) != "" {
+			if err := s.svc.RecordCharge(ctx, "", &ch); err != nil {
+				return ev.Key, fmt.Errorf("record charge %s: %w", ch.ID, err)
 			}
-			evt.Data.PaymentID = ch.ID
-			evt.Data.BookingID = bookingID
-			evt.Data.Amount = ch.Amount
-			evt.Data.Currency = ch.Currency
-			// method: ถ้ามี source ให้ใช้ type, ไม่งั้นถือเป็น card
-			if ch.Source != nil && ch.Source.Type != "" {
-				evt.Data.Method = ch.Source.Type
-			} else {
-				evt.Data.Method = "card"
+			if ch.Status == omise.ChargeSuccessful {
+				if _, err := s.svc.CreditTopUp(ctx, &ch); err != nil {
+					return ev.Key, fmt.Errorf("credit top-up %s: %w", ch.ID, err)
+				}
 			}
-			evt.Data.IdemKey = bookingID
+			return ev.Key, nil
+		}
 
-			if err := s.publisher.PublishJSON(context.Background(), s.RoutingPaid, evt); err != nil {
-				log.Printf("[webhook] publish payment.paid error: %v", err)
-			}
-			log.Print("publish payment.paid")
-		} else {
-			evt := PaymentFailed{
-				Event:      "payment.failed",
-				Version:    1,
-				OccurredAt: time.Now().UTC().Format(time.RFC3339),
-			}
-			evt.Data.PaymentID = ch.ID
-			evt.Data.BookingID = bookingID
-			// FailureCode เป็น *string → ต้องเช็ค nil
-			if ch.FailureCode != nil {
-				evt.Data.Reason = *ch.FailureCode
-			} else {
-				evt.Data.Reason = ""
-			}
-			if err := s.publisher.PublishJSON(context.Background(), s.RoutingFailed, evt); err != nil {
-				log.Printf("[webhook] publish payment.failed error: %v", err)
-			}
-			log.Print("publish payment.failed")
+		// ledger กับ payment.paid/failed ใน txn เดียว (relay เป็นคน publish)
+		if err := s.svc.RecordCharge(ctx, "", &ch, service.ChargeEvents(ctx, &ch)...); err != nil {
+			return ev.Key, fmt.Errorf("record charge %s: %w", ch.ID, err)
 		}
 	default:
 		// ข้าม event key อื่น
-		log.Print("default")
+		log.Printf("[webhook] skip event %s key=%s", id, ev.Key)
 	}
-
-	w.WriteHeader(http.StatusOK)
+	return ev.Key, nil
 }
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omise/omise-go"
+)
+
+// Fake gateway ในหน่วยความจำ (PAYMENT_PROVIDER=fake) สำหรับ dev/ทดสอบโดยไม่ต่อ Omise
+//
+// พฤติกรรมตาม card token:
+//   - tokn_fail หรือ tokn_fail_<code>  -> failed (failure_code = code, ค่าเริ่มต้น insufficient_fund)
+//   - tokn_3ds                          -> pending + authorize_uri (เปิด /fake/authorize/<id> เพื่อจบ 3DS)
+//   - อื่น ๆ                            -> successful ทันที
+//
+// source:
+//   - promptpay -> charge pending แล้วสำเร็จเองหลัง AsyncDelay (หรือสั่งผ่าน /fake/complete/<id>)
+//   - อื่น ๆ    -> charge pending + authorize_uri แบบเดียวกับ 3DS
+//
+// charge ที่จบแบบ async จะสร้าง event charge.complete และ POST {"id","key"} ไปที่ WebhookURL
+// เหมือน Omise ยิง webhook (handler จริงจะเรียก RetrieveEvent กลับมาที่ fake นี้)
+type Fake struct {
+	// BaseURL URL ภายนอกของ Handler ใช้สร้าง authorize_uri / QR (เช่น http://localhost:8081)
+	BaseURL string
+	// WebhookURL ปลายทาง webhook (ว่าง = ไม่ยิง)
+	WebhookURL string
+	// AsyncDelay เวลาที่ PromptPay สำเร็จเอง (0 = รอสั่งผ่าน /fake/complete เท่านั้น)
+	AsyncDelay time.Duration
+
+	mu      sync.Mutex
+	seq     int
+	charges map[string]*omise.Charge
+	sources map[string]*omise.Source
+	events  map[string]*omise.Event
+	http    *http.Client
+}
+
+func NewFake(baseURL, webhookURL string, asyncDelay time.Duration) *Fake {
+	return &Fake{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		WebhookURL: webhookURL,
+		AsyncDelay: asyncDelay,
+		charges:    map[string]*omise.Charge{},
+		sources:    map[string]*omise.Source{},
+		events:     map[string]*omise.Event{},
+		http:       &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// nextID ต้องถือ mu อยู่
+func (f *Fake) nextID(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("%s_fake_%06d", prefix, f.seq)
+}
+
+func notFound(kind, id string) error {
+	return &omise.Error{StatusCode: http.StatusNotFound, Code: "not_found", Message: kind + " " + id + " was not found"}
+}
+
+func strPtr(s string) *string { return &s }
+
+func (f *Fake) CreateCharge(_ context.Context, in ChargeRequest) (*omise.Charge, error) {
+	if in.Amount <= 0 || in.Currency == "" || (in.Card == "") == (in.Source == "") {
+		return nil, &omise.Error{StatusCode: http.StatusBadRequest, Code: "invalid_charge", Message: "amount, currency and either card or source are required"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := &omise.Charge{
+		Base:      omise.Base{Object: "charge", ID: f.nextID("chrg"), CreatedAt: time.Now().UTC()},
+		Amount:    in.Amount,
+		Currency:  strings.ToLower(in.Currency),
+		Capture:   true,
+		ReturnURI: in.ReturnURI,
+		Metadata:  maps.Clone(in.Metadata),
+	}
+	async := false
+	switch {
+	case in.Card != "":
+		ch.Card = &omise.Card{Base: omise.Base{Object: "card", ID: "card_" + in.Card}}
+		switch {
+		case strings.HasPrefix(in.Card, "tokn_fail"):
+			code := strings.TrimPrefix(strings.TrimPrefix(in.Card, "tokn_fail"), "_")
+			if code == "" {
+				code = "insufficient_fund"
+			}
+			f.fail(ch, code)
+		case in.Card == "tokn_3ds":
+			ch.Status = omise.ChargePending
+			ch.AuthorizeURI = f.BaseURL + "/fake/authorize/" + ch.ID
+		default:
+			f.succeed(ch)
+		}
+	default:
+		src, ok := f.sources[in.Source]
+		if !ok {
+			return nil, notFound("source", in.Source)
+		}
+		ch.Source = src
+		ch.Status = omise.ChargePending
+		if src.Type == "promptpay" {
+			async = true
+			ch.ExpiresAt = ch.CreatedAt.Add(24 * time.Hour) // เหมือน Omise: QR ใช้ได้ 24 ชม.
+		} else {
+			ch.AuthorizeURI = f.BaseURL + "/fake/authorize/" + ch.ID
+		}
+	}
+	f.charges[ch.ID] = ch
+
+	if async && f.AsyncDelay > 0 {
+		id := ch.ID
+		time.AfterFunc(f.AsyncDelay, func() { f.Complete(id, true) })
+	}
+	return cloneCharge(ch), nil
+}
+
+func (f *Fake) succeed(ch *omise.Charge) {
+	ch.Status = omise.ChargeSuccessful
+	ch.Authorized, ch.Paid = true, true
+	ch.AuthorizedAmount, ch.CapturedAmount = ch.Amount, ch.Amount
+	ch.Transaction = "trxn_" + ch.ID
+}
+
+func (f *Fake) fail(ch *omise.Charge, code string) {
+	ch.Status = omise.ChargeFailed
+	ch.FailureCode = strPtr(code)
+	ch.FailureMessage = strPtr("fake gateway: " + code)
+}
+
+func (f *Fake) CreateSource(_ context.Context, in SourceRequest) (*omise.Source, error) {
+	if in.Type == "" || in.Amount <= 0 || in.Currency == "" {
+		return nil, &omise.Error{StatusCode: http.StatusBadRequest, Code: "invalid_source", Message: "type, amount and currency are required"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	src := &omise.Source{
+		Base:     omise.Base{Object: "source", ID: f.nextID("src"), CreatedAt: time.Now().UTC()},
+		Type:     in.Type,
+		Flow:     "redirect",
+		Amount:   in.Amount,
+		Currency: strings.ToLower(in.Currency),
+	}
+	if in.Type == "promptpay" {
+		src.Flow = "offline"
+		src.ScannableCode = &omise.ScannableCode{
+			Object: "barcode",
+			Type:   "qr",
+			Image: &omise.Document{
+				Base:        omise.Base{Object: "document", ID: "docu_" + src.ID},
+				Filename:    "qrcode.svg",
+				DownloadURI: f.BaseURL + "/fake/qr/" + src.ID,
+			},
+		}
+	}
+	f.sources[src.ID] = src
+	return cloneSource(src), nil
+}
+
+func (f *Fake) RetrieveSource(_ context.Context, id string) (*omise.Source, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	src, ok := f.sources[id]
+	if !ok {
+		return nil, notFound("source", id)
+	}
+	return cloneSource(src), nil
+}
+
+func (f *Fake) RetrieveCharge(_ context.Context, id string) (*omise.Charge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ch, ok := f.charges[id]
+	if !ok {
+		return nil, notFound("charge", id)
+	}
+	return cloneCharge(ch), nil
+}
+
+func (f *Fake) ListCharges(_ context.Context, from, to time.Time) ([]*omise.Charge, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*omise.Charge
+	for _, ch := range f.charges {
+		if !ch.CreatedAt.Before(from) && ch.CreatedAt.Before(to) {
+			out = append(out, cloneCharge(ch))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (f *Fake) RetrieveEvent(_ context.Context, id string) (*omise.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ev, ok := f.events[id]
+	if !ok {
+		return nil, notFound("event", id)
+	}
+	cp := *ev
+	if ch, ok := ev.Data.(*omise.Charge); ok {
+		cp.Data = cloneCharge(ch)
+	}
+	return &cp, nil
+}
+
+func (f *Fake) Refund(_ context.Context, chargeID string, amount int64, metadata map[string]any) (*omise.Refund, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ch, ok := f.charges[chargeID]
+	if !ok {
+		return nil, notFound("charge", chargeID)
+	}
+	remaining := ch.Amount - ch.RefundedAmount
+	if amount == 0 {
+		amount = remaining
+	}
+	if ch.Status != omise.ChargeSuccessful || amount <= 0 || amount > remaining {
+		return nil, &omise.Error{StatusCode: http.StatusBadRequest, Code: "invalid_refund", Message: "charge is not refundable for this amount"}
+	}
+	rf := &omise.Refund{
+		Base:     omise.Base{Object: "refund", ID: f.nextID("rfnd"), CreatedAt: time.Now().UTC()},
+		Status:   "closed",
+		Amount:   amount,
+		Currency: ch.Currency,
+		Charge:   ch.ID,
+		Metadata: maps.Clone(metadata),
+	}
+	ch.RefundedAmount += amount
+	if ch.Refunds == nil {
+		ch.Refunds = &omise.RefundList{}
+	}
+	ch.Refunds.Data = append(ch.Refunds.Data, rf)
+	ch.Refunds.Total = len(ch.Refunds.Data)
+	cp := *rf
+	cp.Metadata = maps.Clone(rf.Metadata)
+	return &cp, nil
+}
+
+// cloneCharge สำเนาลึกของ ch: ผู้เรียกแก้ค่าที่ได้ไป (Metadata, Refunds, ...) ต้องไม่กระทบ state ใน fake
+// ต้องถือ mu อยู่; field ที่ fake ไม่เคยตั้ง (Dispute, AuthenticatedBy) ไม่ต้อง copy
+func cloneCharge(ch *omise.Charge) *omise.Charge {
+	cp := *ch
+	cp.Description = cloneStr(ch.Description)
+	cp.IP = cloneStr(ch.IP)
+	cp.FailureCode = cloneStr(ch.FailureCode)
+	cp.FailureMessage = cloneStr(ch.FailureMessage)
+	if ch.Card != nil {
+		card := *ch.Card
+		cp.Card = &card
+	}
+	if ch.Source != nil {
+		cp.Source = cloneSource(ch.Source)
+	}
+	if ch.Refunds != nil {
+		list := *ch.Refunds
+		list.Data = make([]*omise.Refund, len(ch.Refunds.Data))
+		for i, rf := range ch.Refunds.Data {
+			r := *rf
+			r.Metadata = maps.Clone(rf.Metadata)
+			list.Data[i] = &r
+		}
+		cp.Refunds = &list
+	}
+	cp.Metadata = maps.Clone(ch.Metadata)
+	cp.Missing3DSFields = append([]string(nil), ch.Missing3DSFields...)
+	return &cp
+}
+
+func cloneSource(src *omise.Source) *omise.Source {
+	cp := *src
+	if src.ScannableCode != nil {
+		sc := *src.ScannableCode
+		if sc.Image != nil {
+			img := *sc.Image
+			sc.Image = &img
+		}
+		cp.ScannableCode = &sc
+	}
+	if src.References != nil {
+		ref := *src.References
+		cp.References = &ref
+	}
+	return &cp
+}
+
+func cloneStr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return strPtr(*s)
+}
+
+// Complete จบ charge ที่ pending (สำเร็จ/ล้มเหลว) แล้วยิง webhook charge.complete
+func (f *Fake) Complete(chargeID string, success bool) error {
+	f.mu.Lock()
+	ch, ok := f.charges[chargeID]
+	if !ok {
+		f.mu.Unlock()
+		return notFound("charge", chargeID)
+	}
+	if ch.Status != omise.ChargePending {
+		f.mu.Unlock()
+		return nil
+	}
+	if success {
+		f.succeed(ch)
+	} else {
+		f.fail(ch, "payment_rejected")
+	}
+	ev := &omise.Event{
+		Base: omise.Base{Object: "event", ID: f.nextID("evnt"), CreatedAt: time.Now().UTC()},
+		Key:  "charge.complete",
+		Data: cloneCharge(ch),
+	}
+	f.events[ev.ID] = ev
+	f.mu.Unlock()
+
+	go f.deliver(ev)
+	return nil
+}
+
+// deliver ส่ง webhook แบบเดียวกับ Omise (body มีแค่ id/key ก็พอสำหรับ handler ที่ดึง event ซ้ำ)
+func (f *Fake) deliver(ev *omise.Event) {
+	if f.WebhookURL == "" {
+		return
+	}
+	body, _ := json.Marshal(map[string]string{"object": "event", "id": ev.ID, "key": ev.Key})
+	res, err := f.http.Post(f.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		log.Printf("[fake-gateway] webhook %s error: %v", ev.ID, err)
+		return
+	}
+	res.Body.Close()
+	log.Printf("[fake-gateway] webhook %s %s -> %d", ev.ID, ev.Key, res.StatusCode)
+}
+
+// Handler หน้าจำลองฝั่ง gateway:
+//
+//	GET /fake/authorize/<charge_id>[?result=fail]  จบ 3DS/redirect แล้ว redirect ไป return_uri (ถ้ามี)
+//	POST /fake/complete/<charge_id>[?result=fail]  จบ charge แบบ async (เช่น สแกน PromptPay แล้ว)
+//	GET /fake/qr/<source_id>                        รูป QR (SVG placeholder)
+func (f *Fake) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /fake/authorize/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if err := f.Complete(id, r.URL.Query().Get("result") != "fail"); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		ch, _ := f.RetrieveCharge(r.Context(), id)
+		if ch != nil && ch.ReturnURI != "" {
+			http.Redirect(w, r, ch.ReturnURI, http.StatusFound)
+			return
+		}
+		fmt.Fprintf(w, "charge %s: %s\n", id, ch.Status)
+	})
+	mux.HandleFunc("POST /fake/complete/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if err := f.Complete(r.PathValue("id"), r.URL.Query().Get("result") != "fail"); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /fake/qr/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := f.RetrieveSource(r.Context(), r.PathValue("id")); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="200" height="200"><rect width="200" height="200" fill="#fff" stroke="#000"/><text x="100" y="105" text-anchor="middle" font-size="12">%s</text></svg>`, r.PathValue("id"))
+	})
+	return mux
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/omise/omise-go"
+	"github.com/omise/omise-go/operations"
+)
+
+// Omise ต่อ Omise จริงผ่าน omise-go (และ REST ตรงสำหรับ source ที่ SDK ส่ง return_uri ไม่ได้)
+type Omise struct {
+	c          *omise.Client
+	secretKey  string // ใช้ยิง REST (Basic Auth) -> skey_xxx
+	apiVersion string // header Omise-Version (ว่าง = ค่าที่ SDK pin ไว้)
+	http       *http.Client
+}
+
+func NewOmise(pub, sec, apiVersion string) (*Omise, error) {
+	c, err := omise.NewClient(pub, sec)
+	if err != nil {
+		return nil, err
+	}
+	if apiVersion != "" {
+		// pin version ให้พฤติกรรม API คงที่ (custom header ทับ Omise-Version ของ SDK)
+		c.WithCustomHeaders(map[string]string{"Omise-Version": apiVersion})
+	}
+	c.SetDebug(false)
+	return &Omise{c: c, secretKey: sec, apiVersion: apiVersion, http: &http.Client{Timeout: 15 * time.Second}}, nil
+}
+
+func (o *Omise) CreateCharge(ctx context.Context, in ChargeRequest) (*omise.Charge, error) {
+	ch := &omise.Charge{}
+	req := &operations.CreateCharge{
+		Amount:    in.Amount,
+		Currency:  in.Currency,
+		Card:      in.Card,
+		Source:    in.Source,
+		ReturnURI: in.ReturnURI,
+		Metadata:  in.Metadata,
+	}
+	if err := o.c.Do(ch, req); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (o *Omise) CreateSource(ctx context.Context, in SourceRequest) (*omise.Source, error) {
+	// promptpay ไม่ต้อง return_uri -> ใช้ SDK ได้เลย
+	if strings.EqualFold(in.Type, "promptpay") {
+		src := &omise.Source{}
+		req := &operations.CreateSource{Type: in.Type, Amount: in.Amount, Currency: in.Currency}
+		if err := o.c.Do(src, req); err != nil {
+			return nil, err
+		}
+		return src, nil
+	}
+	// ช่องทางที่ต้อง redirect (เช่น mobile_banking_kbank / internet_banking_*)
+	return o.createSourceViaREST(ctx, in)
+}
+
+// createSourceViaREST: ยิง REST ไป Omise เพื่อสร้าง source ที่ต้องการ return_uri
+func (o *Omise) createSourceViaREST(ctx context.Context, in SourceRequest) (*omise.Source, error) {
+	if o.secretKey == "" {
+		return nil, errors.New("missing Omise secret key for REST call")
+	}
+
+	form := url.Values{}
+	form.Set("type", in.Type)
+	form.Set("amount", strconv.FormatInt(in.Amount, 10))
+	form.Set("currency", in.Currency)
+	// บางช่องทางจำเป็นต้องมี return_uri (เช่น internet_banking_*, mobile_banking_*)
+	if in.ReturnURI != "" {
+		form.Set("return_uri", in.ReturnURI)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.omise.co/sources", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if o.apiVersion != "" {
+		req.Header.Set("Omise-Version", o.apiVersion)
+	}
+	// Basic Auth: username = skey_xxx, password = "" (ว่าง)
+	req.SetBasicAuth(o.secretKey, "")
+
+	res, err := o.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("omise create source failed: %s (%d)", string(body), res.StatusCode)
+	}
+
+	// ถอดรหัสเป็น omise.Source
+	var src omise.Source
+	if err := json.Unmarshal(body, &src); err != nil {
+		return nil, fmt.Errorf("parse source json failed: %w", err)
+	}
+	return &src, nil
+}
+
+func (o *Omise) RetrieveSource(ctx context.Context, id string) (*omise.Source, error) {
+	src := &omise.Source{}
+	if err := o.c.Do(src, &operations.RetrieveSource{SourceID: id}); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+func (o *Omise) RetrieveCharge(ctx context.Context, id string) (*omise.Charge, error) {
+	ch := &omise.Charge{}
+	if err := o.c.Do(ch, &operations.RetrieveCharge{ChargeID: id}); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// ListCharges ไล่ทีละหน้า (Omise จำกัด 100 ต่อหน้า)
+func (o *Omise) ListCharges(ctx context.Context, from, to time.Time) ([]*omise.Charge, error) {
+	const limit = 100
+	var out []*omise.Charge
+	for offset := 0; ; offset += limit {
+		list := &omise.ChargeList{}
+		req := &operations.ListCharges{List: operations.List{From: from, To: to, Offset: offset, Limit: limit, Order: omise.Chronological}}
+		if err := o.c.Do(list, req); err != nil {
+			return nil, err
+		}
+		out = append(out, list.Data...)
+		if len(list.Data) < limit || offset+limit >= list.Total {
+			return out, nil
+		}
+	}
+}
+
+func (o *Omise) RetrieveEvent(ctx context.Context, id string) (*omise.Event, error) {
+	ev := &omise.Event{}
+	if err := o.c.Do(ev, &operations.RetrieveEvent{EventID: id}); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+func (o *Omise) Refund(ctx context.Context, chargeID string, amount int64, metadata map[string]any) (*omise.Refund, error) {
+	if amount == 0 {
+		ch, err := o.RetrieveCharge(ctx, chargeID)
+		if err != nil {
+			return nil, err
+		}
+		amount = ch.Amount - ch.RefundedAmount
+	}
+	rf := &omise.Refund{}
+	if err := o.c.Do(rf, &operations.CreateRefund{ChargeID: chargeID, Amount: amount, Metadata: metadata}); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
@@ -0,0 +1,42 @@
+// Package provider ชั้นกลางระหว่าง PaymentSvc กับ payment gateway (Omise จริง หรือ fake ในหน่วยความจำ)
+// ใช้ type ของ omise-go เป็นโมเดลกลาง เพื่อให้โค้ดส่วนอื่นไม่ต้องแปลงไปมา
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/omise/omise-go"
+)
+
+// ChargeRequest ระบุ Card (token) หรือ Source อย่างใดอย่างหนึ่ง
+type ChargeRequest struct {
+	Amount    int64
+	Currency  string
+	Card      string
+	Source    string
+	ReturnURI string // หน้าที่ผู้ใช้กลับมาหลัง 3DS/redirect
+	Metadata  map[string]any
+}
+
+// SourceRequest ReturnURI จำเป็นสำหรับช่องทาง redirect (internet_banking_*, mobile_banking_*)
+type SourceRequest struct {
+	Type      string
+	Amount    int64
+	Currency  string
+	ReturnURI string
+}
+
+// PaymentProvider ทุกอย่างที่ payment-service ต้องใช้จาก gateway
+type PaymentProvider interface {
+	CreateCharge(ctx context.Context, in ChargeRequest) (*omise.Charge, error)
+	CreateSource(ctx context.Context, in SourceRequest) (*omise.Source, error)
+	RetrieveSource(ctx context.Context, id string) (*omise.Source, error)
+	RetrieveCharge(ctx context.Context, id string) (*omise.Charge, error)
+	// RetrieveEvent ใช้ยืนยัน webhook (ดึง event จาก gateway อีกรอบแทนการเชื่อ body)
+	RetrieveEvent(ctx context.Context, id string) (*omise.Event, error)
+	// ListCharges charge ทั้งหมดที่สร้างในช่วง [from, to) (ใช้ reconcile กับ ledger)
+	ListCharges(ctx context.Context, from, to time.Time) ([]*omise.Charge, error)
+	// Refund amount = 0 คือคืนเต็มยอดที่เหลือ; metadata ติดไปกับ refund (กลับมาใน Charge.Refunds)
+	Refund(ctx context.Context, chargeID string, amount int64, metadata map[string]any) (*omise.Refund, error)
+}
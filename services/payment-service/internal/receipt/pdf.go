@@ -0,0 +1,149 @@
+// Package receipt render ใบเสร็จ/ใบกำกับภาษีอย่างย่อเป็น PDF
+package receipt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/signintech/gopdf"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// ขอบกระดาษ A4 (มม.)
+const (
+	left  = 20.0
+	width = 170.0 // 210 - ขอบซ้าย/ขวา
+)
+
+// Renderer พิมพ์ใบเสร็จสองภาษาด้วย font TTF ที่มีภาษาไทย (เช่น Sarabun, Loma)
+type Renderer struct {
+	font []byte
+	loc  *time.Location // เวลาบนใบเสร็จ
+}
+
+// NewRenderer ต้องมี font: ใบเสร็จมีหัวข้อและชื่อสนามภาษาไทย (font ละตินพิมพ์ไม่ออก)
+func NewRenderer(fontPath string, loc *time.Location) (*Renderer, error) {
+	if fontPath == "" {
+		return nil, errors.New("receipt font is required (TTF with Thai glyphs)")
+	}
+	b, err := os.ReadFile(fontPath)
+	if err != nil {
+		return nil, fmt.Errorf("read receipt font: %w", err)
+	}
+	f := new(gopdf.SubsetFontObj)
+	if err := f.SetTTFData(b); err != nil {
+		return nil, fmt.Errorf("parse receipt font %s: %w", fontPath, err)
+	}
+	if g, err := f.CharCodeToGlyphIndex('ก'); err != nil || g == 0 {
+		return nil, fmt.Errorf("receipt font %s has no Thai glyphs", fontPath)
+	}
+	return &Renderer{font: b, loc: loc}, nil
+}
+
+func (r *Renderer) Render(rc *domain.Receipt) ([]byte, error) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4, Unit: gopdf.UnitMM})
+	pdf.SetInfo(gopdf.PdfInfo{Title: rc.Number, CreationDate: rc.PaidAt})
+	if err := pdf.AddTTFFontData("receipt", r.font); err != nil {
+		return nil, fmt.Errorf("load receipt font: %w", err)
+	}
+	pdf.AddPage()
+
+	// วาดทีละบรรทัดจากบนลงล่าง เก็บ error แรกไว้คืนตอนจบ
+	var err error
+	y := 20.0
+	keep := func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+	font := func(size float64) { keep(pdf.SetFont("receipt", "", size)) }
+	text := func(x, w, h float64, s string, align int) {
+		pdf.SetXY(x, y)
+		keep(pdf.CellWithOption(&gopdf.Rect{W: w, H: h}, s, gopdf.CellOption{Align: align | gopdf.Middle}))
+	}
+	line := func(h float64, s string) {
+		text(left, width, h, s, gopdf.Center)
+		y += h
+	}
+	row := func(k, v string) {
+		text(left, 55, 7, k, gopdf.Left)
+		text(left+55, width-55, 7, v, gopdf.Left)
+		y += 7
+	}
+	money := func(k string, satang int64) {
+		text(left, 120, 7, k, gopdf.Right)
+		text(left+120, width-120, 7, formatAmount(satang)+" "+strings.ToUpper(rc.Currency), gopdf.Right)
+		y += 7
+	}
+	rule := func() { pdf.Line(left, y, left+width, y) }
+	label := func(th, en string) string { return th + " / " + en }
+
+	// หัวเอกสาร
+	font(16)
+	title := label("ใบเสร็จรับเงิน", "Receipt")
+	if rc.Kind == domain.ReceiptTaxInvoice {
+		title = label("ใบเสร็จรับเงิน/ใบกำกับภาษีอย่างย่อ", "Receipt / Abbreviated Tax Invoice")
+	}
+	line(10, title)
+	font(11)
+	line(6, rc.SellerName)
+	if rc.SellerAddr != "" {
+		line(6, rc.SellerAddr)
+	}
+	if rc.SellerTaxID != "" {
+		line(6, label("เลขประจำตัวผู้เสียภาษี", "Tax ID")+": "+rc.SellerTaxID)
+	}
+	y += 6
+
+	row(label("เลขที่", "No."), rc.Number)
+	row(label("วันที่", "Date"), rc.PaidAt.In(r.loc).Format("02 Jan 2006 15:04"))
+	row(label("ชำระโดย", "Payment method"), rc.Method)
+	row(label("อ้างอิง", "Reference"), rc.ChargeID)
+	y += 4
+
+	// รายการ
+	rule()
+	y += 2
+	row(label("สนาม", "Venue"), rc.Venue)
+	row(label("คอร์ท", "Court"), fmt.Sprintf("%d", rc.CourtNo))
+	start, end := rc.StartTime.In(r.loc), rc.EndTime.In(r.loc)
+	row(label("เวลา", "Time"), start.Format("02 Jan 2006 15:04")+" - "+end.Format("15:04"))
+	row(label("การจอง", "Booking"), rc.BookingID)
+	y += 2
+	rule()
+	y += 2
+
+	// ยอด (ราคารวม VAT แล้ว)
+	if rc.VATAmount > 0 {
+		money(label("มูลค่าก่อนภาษี", "Amount before VAT"), rc.Amount-rc.VATAmount)
+		money(label("ภาษีมูลค่าเพิ่ม", "VAT")+fmt.Sprintf(" %g%%", rc.VATPercent), rc.VATAmount)
+	}
+	font(13)
+	money(label("รวมทั้งสิ้น", "Total"), rc.Amount)
+	if rc.VATAmount > 0 {
+		font(9)
+		text(left, width, 6, label("ราคารวมภาษีมูลค่าเพิ่มแล้ว", "VAT included"), gopdf.Right)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pdf.GetBytesPdfReturnErr()
+}
+
+// formatAmount สตางค์ -> "1,234.50"
+func formatAmount(satang int64) string {
+	sign := ""
+	if satang < 0 {
+		sign, satang = "-", -satang
+	}
+	baht := fmt.Sprintf("%d", satang/100)
+	for i := len(baht) - 3; i > 0; i -= 3 {
+		baht = baht[:i] + "," + baht[i:]
+	}
+	return fmt.Sprintf("%s%s.%02d", sign, baht, satang%100)
+}
@@ -0,0 +1,55 @@
+package reconciler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/service"
+)
+
+// grace ไม่ตรวจ charge ที่เพิ่งสร้าง (webhook อาจยังมาไม่ถึง)
+const grace = 5 * time.Minute
+
+// Reconciler ตามเก็บ payment.paid/failed ที่ webhook หาย โดยเทียบ charge ตั้งแต่รอบก่อน (ไม่เกิน lookback) กับ ledger ทุก interval
+type Reconciler struct {
+	svc      *service.PaymentSvc
+	interval time.Duration
+	lookback time.Duration
+}
+
+func New(svc *service.PaymentSvc, interval, lookback time.Duration) *Reconciler {
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	if lookback <= 0 {
+		lookback = 48 * time.Hour
+	}
+	return &Reconciler{svc: svc, interval: interval, lookback: lookback}
+}
+
+// Run วนทุก interval จนกว่า ctx จะถูก cancel (เรียกใน goroutine)
+func (w *Reconciler) Run(ctx context.Context) {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		w.reconcile(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *Reconciler) reconcile(ctx context.Context) {
+	to := time.Now().UTC().Add(-grace)
+	run, err := w.svc.ReconcileSince(ctx, w.lookback, to)
+	if err != nil {
+		log.Printf("[reconcile] error: %v", err)
+		return
+	}
+	if len(run.Items) > 0 {
+		log.Printf("[reconcile] run %s: %d charge(s), %d discrepancy(ies)", run.ID, run.ChargesChecked, len(run.Items))
+	}
+}
@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+type PaymentRepo struct{ db *gorm.DB }
+
+func NewPaymentRepo(db *gorm.DB) *PaymentRepo {
+	return &PaymentRepo{db: db}
+}
+
+func (r *PaymentRepo) Migrate() error {
+	if err := r.db.AutoMigrate(&domain.Payment{}, &domain.Refund{}, &domain.IdempotencyKey{}, &domain.WebhookEvent{},
+		&domain.ReconciliationRun{}, &domain.ReconciliationItem{}, &domain.Payout{}, &domain.PayoutLine{},
+		&domain.Wallet{}, &domain.WalletTransaction{}, &domain.Receipt{}, &domain.ReceiptSequence{}, &domain.PaymentLock{}); err != nil {
+		return err
+	}
+	return outbox.Migrate(r.db)
+}
+
+// Enqueue เขียน payment.* ลง outbox อย่างเดียว (เหตุการณ์ที่ไม่มีแถว ledger ให้เขียนคู่กัน)
+func (r *PaymentRepo) Enqueue(ctx context.Context, evs ...outbox.Event) error {
+	return outbox.Enqueue(r.db.WithContext(ctx), evs...)
+}
+
+// Upsert บันทึกสถานะล่าสุดของ charge พร้อม evs ลง outbox ใน txn เดียวกัน
+// webhook ไม่รู้ user_id จึงไม่ทับ user_id/created_at เดิม
+// สถานะเดินหน้าอย่างเดียว: แถวที่จบแล้ว (terminal) ไม่ถูกข้อมูลเก่าที่มาช้ากว่า (เช่น pending) ทับ
+func (r *PaymentRepo) Upsert(ctx context.Context, p *domain.Payment, evs ...outbox.Event) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := upsertPayment(tx, p); err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, evs...)
+	})
+}
+
+// staleUpsert แถวเดิมจบแล้วแต่ข้อมูลที่เข้ามาเป็นสถานะอื่น = ข้อมูลเก่า (charge ที่จบแล้วไม่เปลี่ยนสถานะอีก)
+const staleUpsert = "payments.status IN ('successful', 'failed', 'expired', 'reversed') AND EXCLUDED.status <> payments.status"
+
+// unlessStale ใช้ค่าใหม่ของ col เว้นแต่ข้อมูลที่เข้ามาเก่ากว่าแถวเดิม
+func unlessStale(col string) clause.Expr {
+	return gorm.Expr("CASE WHEN " + staleUpsert + " THEN payments." + col + " ELSE EXCLUDED." + col + " END")
+}
+
+func upsertPayment(tx *gorm.DB, p *domain.Payment) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "charge_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"user_id":    gorm.Expr("COALESCE(NULLIF(payments.user_id, ''), EXCLUDED.user_id)"),
+			"booking_id": gorm.Expr("COALESCE(NULLIF(EXCLUDED.booking_id, ''), payments.booking_id)"),
+			"share_id":   gorm.Expr("COALESCE(NULLIF(EXCLUDED.share_id, ''), payments.share_id)"),
+			// ยอด/สกุลเงินตายตัวเมื่อ charge จบแล้ว
+			"amount":          gorm.Expr("CASE WHEN payments.status = 'pending' THEN EXCLUDED.amount ELSE payments.amount END"),
+			"currency":        gorm.Expr("CASE WHEN payments.status = 'pending' THEN EXCLUDED.currency ELSE payments.currency END"),
+			"method":          unlessStale("method"),
+			"status":          unlessStale("status"),
+			"refunded_amount": gorm.Expr("GREATEST(payments.refunded_amount, EXCLUDED.refunded_amount)"),
+			"failure_code":    unlessStale("failure_code"),
+			"failure_message": unlessStale("failure_message"),
+			"raw":             unlessStale("raw"),
+			"paid_at":         gorm.Expr("COALESCE(payments.paid_at, EXCLUDED.paid_at)"),
+			"updated_at":      gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(p).Error
+}
+
+// SuccessfulByBooking charge ที่จ่ายทั้ง booking และสำเร็จแล้ว (gorm.ErrRecordNotFound = ยังไม่มี)
+// charge ของส่วนหารจ่ายไม่นับ (ดู SuccessfulByShare)
+func (r *PaymentRepo) SuccessfulByBooking(ctx context.Context, bookingID string) (*domain.Payment, error) {
+	var p domain.Payment
+	if err := r.db.WithContext(ctx).Where("booking_id = ? AND COALESCE(share_id, '') = '' AND status = ?", bookingID, "successful").First(&p).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// SuccessfulByShare charge ที่สำเร็จแล้วของส่วน shareID (gorm.ErrRecordNotFound = ยังไม่มี)
+func (r *PaymentRepo) SuccessfulByShare(ctx context.Context, shareID string) (*domain.Payment, error) {
+	var p domain.Payment
+	if err := r.db.WithContext(ctx).Where("share_id = ? AND status = ?", shareID, "successful").First(&p).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ClaimKey จอง key ให้คำขอนี้; claimed = false คือมีคำขอเดิมอยู่แล้ว (คืนแถวเดิม)
+// แถวของ booking เดียวกันที่ยังไม่มี charge และไม่ขยับนานกว่า stale = คำขอเดิมตายกลางทาง: รับช่วงต่อ
+func (r *PaymentRepo) ClaimKey(ctx context.Context, key, bookingID string, stale time.Duration) (claimed bool, existing *domain.IdempotencyKey, err error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.IdempotencyKey{Key: key, BookingID: bookingID})
+	if res.Error != nil {
+		return false, nil, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil, nil
+	}
+	var k domain.IdempotencyKey
+	if err := r.db.WithContext(ctx).First(&k, "key = ?", key).Error; err != nil {
+		return false, nil, err
+	}
+	if k.ChargeID != "" || k.BookingID != bookingID || time.Since(k.UpdatedAt) < stale {
+		return false, &k, nil
+	}
+	// updated_at เดิมเป็นเงื่อนไข: ผู้รับช่วงพร้อมกันได้แค่รายเดียว
+	res = r.db.WithContext(ctx).Model(&domain.IdempotencyKey{}).
+		Where("key = ? AND charge_id = '' AND updated_at = ?", key, k.UpdatedAt).
+		Update("updated_at", time.Now())
+	if res.Error != nil {
+		return false, nil, res.Error
+	}
+	return res.RowsAffected == 1, &k, nil
+}
+
+// LockPayment จอง target ให้ token จนถึง now+ttl; false = มีผู้ถืออยู่และยังไม่หมดอายุ
+func (r *PaymentRepo) LockPayment(ctx context.Context, target, token string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "target"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token", "expires_at"}),
+		Where:     clause.Where{Exprs: []clause.Expression{gorm.Expr("payment_locks.expires_at < ?", now)}},
+	}).Create(&domain.PaymentLock{Target: target, Token: token, ExpiresAt: now.Add(ttl)})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// UnlockPayment ปล่อย lock ของ token นี้ (หมดอายุแล้วถูกคนอื่นจองไป = ไม่ลบของเขา)
+func (r *PaymentRepo) UnlockPayment(ctx context.Context, target, token string) error {
+	return r.db.WithContext(ctx).Where("target = ? AND token = ?", target, token).Delete(&domain.PaymentLock{}).Error
+}
+
+func (r *PaymentRepo) CompleteKey(ctx context.Context, key, chargeID string) error {
+	return r.db.WithContext(ctx).Model(&domain.IdempotencyKey{}).Where("key = ?", key).Update("charge_id", chargeID).Error
+}
+
+// ReleaseKey ลบ key ที่สร้าง charge ไม่สำเร็จ ให้ลองใหม่ด้วย key เดิมได้
+func (r *PaymentRepo) ReleaseKey(ctx context.Context, key string) error {
+	return r.db.WithContext(ctx).Where("key = ? AND charge_id = ''", key).Delete(&domain.IdempotencyKey{}).Error
+}
+
+// ErrRefundFinished แถว pending ถูกปิด/ปล่อยไปแล้ว (มีคำขออื่นรับช่วงไป)
+var ErrRefundFinished = errors.New("refund is no longer pending")
+
+// ClaimRefund จองแถว pending ของ rf.Key ก่อนเรียก gateway
+// claimed = false คือมีแถวของ key นี้อยู่แล้ว (คืน existing); แถว pending ที่ไม่ขยับนานกว่า stale
+// ถือว่าคำขอเดิมตายกลางทาง: รับช่วงต่อ (claimed = true, existing = แถวเดิม)
+func (r *PaymentRepo) ClaimRefund(ctx context.Context, rf *domain.Refund, stale time.Duration) (claimed bool, existing *domain.Refund, err error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "key"}}, DoNothing: true}).Create(rf)
+	if res.Error != nil {
+		return false, nil, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil, nil
+	}
+	prev, err := r.RefundByKey(ctx, rf.Key)
+	if err != nil {
+		return false, nil, err
+	}
+	if prev.Status != domain.RefundPending || time.Since(prev.UpdatedAt) < stale {
+		return false, prev, nil
+	}
+	// updated_at เดิมเป็นเงื่อนไข: ผู้รับช่วงพร้อมกันได้แค่รายเดียว
+	res = r.db.WithContext(ctx).Model(&domain.Refund{}).
+		Where("key = ? AND status = ? AND updated_at = ?", prev.Key, domain.RefundPending, prev.UpdatedAt).
+		Update("updated_at", time.Now())
+	if res.Error != nil {
+		return false, nil, res.Error
+	}
+	return res.RowsAffected == 1, prev, nil
+}
+
+// FinishRefund ปิดแถว pending ของ rf.Key เป็น succeeded (id/ยอดจริงจาก gateway) พร้อม evs (payment.refunded) ใน txn เดียวกัน
+func (r *PaymentRepo) FinishRefund(ctx context.Context, rf *domain.Refund, evs ...outbox.Event) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&domain.Refund{}).Where("key = ? AND status = ?", rf.Key, domain.RefundPending).
+			Updates(map[string]any{"id": rf.ID, "amount": rf.Amount, "status": domain.RefundSucceeded, "updated_at": time.Now()})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrRefundFinished
+		}
+		return outbox.Enqueue(tx, evs...)
+	})
+}
+
+// ReleaseRefund ลบแถว pending ที่ gateway ปฏิเสธ/ยังไม่ได้เรียก ให้ลองใหม่ด้วย key เดิมได้
+func (r *PaymentRepo) ReleaseRefund(ctx context.Context, key string) error {
+	return r.db.WithContext(ctx).Where("key = ? AND status = ?", key, domain.RefundPending).Delete(&domain.Refund{}).Error
+}
+
+// RefundByKey คืน gorm.ErrRecordNotFound ถ้ายังไม่เคยคืนด้วย key นี้
+func (r *PaymentRepo) RefundByKey(ctx context.Context, key string) (*domain.Refund, error) {
+	var rf domain.Refund
+	if err := r.db.WithContext(ctx).First(&rf, "key = ?", key).Error; err != nil {
+		return nil, err
+	}
+	return &rf, nil
+}
+
+func (r *PaymentRepo) ByCharge(ctx context.Context, chargeID string) (*domain.Payment, error) {
+	var p domain.Payment
+	if err := r.db.WithContext(ctx).First(&p, "charge_id = ?", chargeID).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PaymentRepo) ByBooking(ctx context.Context, bookingID string) ([]domain.Payment, error) {
+	var out []domain.Payment
+	err := r.db.WithContext(ctx).Where("booking_id = ?", bookingID).Order("created_at ASC").Find(&out).Error
+	return out, err
+}
+
+// ListFilter เงื่อนไขของ List (ค่าว่าง = ไม่กรอง)
+type ListFilter struct {
+	UserID    string
+	BookingID string
+	Status    string
+}
+
+// maxPageSize เพดาน page_size ของทุก list (client ขอเกินได้แค่นี้)
+const maxPageSize = 100
+
+// paging แปลง page/page_size ของ client เป็น limit/offset (ค่าเริ่มต้น 20, ไม่เกิน maxPageSize)
+func paging(page, size int32) (limit, offset int) {
+	switch {
+	case size <= 0:
+		size = 20
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	if page < 0 {
+		page = 0
+	}
+	return int(size), int(page) * int(size)
+}
+
+func (r *PaymentRepo) List(ctx context.Context, page, size int32, f ListFilter) ([]domain.Payment, int64, error) {
+	limit, offset := paging(page, size)
+	qb := r.db.WithContext(ctx).Model(&domain.Payment{})
+	if f.UserID != "" {
+		qb = qb.Where("user_id = ?", f.UserID)
+	}
+	if f.BookingID != "" {
+		qb = qb.Where("booking_id = ?", f.BookingID)
+	}
+	if f.Status != "" {
+		qb = qb.Where("status = ?", f.Status)
+	}
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var out []domain.Payment
+	if err := qb.Order("created_at DESC").Limit(limit).Offset(offset).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// UnsettledCharges charge ที่จ่ายสำเร็จในช่วง [from, to) และยังไม่อยู่ในใบสรุปใด
+func (r *PaymentRepo) UnsettledCharges(ctx context.Context, from, to time.Time) ([]domain.Payment, error) {
+	var out []domain.Payment
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND created_at >= ? AND created_at < ? AND booking_id <> ''", "successful", from, to). // ไม่รวมการเติม wallet
+		Where("NOT EXISTS (SELECT 1 FROM payout_lines l WHERE l.kind = ? AND l.ref_id = payments.charge_id)", domain.PayoutLineCharge).
+		Order("created_at ASC").Find(&out).Error
+	return out, err
+}
+
+// UnsettledRefunds การคืนเงินในช่วง [from, to) ที่ยังไม่อยู่ในใบสรุปใด
+func (r *PaymentRepo) UnsettledRefunds(ctx context.Context, from, to time.Time) ([]domain.Refund, error) {
+	var out []domain.Refund
+	err := r.db.WithContext(ctx).
+		Where("created_at >= ? AND created_at < ? AND status = ?", from, to, domain.RefundSucceeded).
+		Where("NOT EXISTS (SELECT 1 FROM payout_lines l WHERE l.kind = ? AND l.ref_id = refunds.id)", domain.PayoutLineRefund).
+		Order("created_at ASC").Find(&out).Error
+	return out, err
+}
+
+// HasPayout มีใบสรุปของ owner ในงวดนี้แล้วหรือยัง
+func (r *PaymentRepo) HasPayout(ctx context.Context, ownerID string, periodStart time.Time, currency string) (bool, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&domain.Payout{}).
+		Where("owner_id = ? AND period_start = ? AND currency = ?", ownerID, periodStart, currency).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// CreatePayout บันทึกใบสรุปพร้อม lines ใน transaction เดียว
+// unique (kind, ref_id) ของ lines กันไม่ให้ charge/refund ถูกนับซ้ำแม้ออกใบพร้อมกันหลาย instance
+func (r *PaymentRepo) CreatePayout(ctx context.Context, p *domain.Payout) error {
+	return r.db.WithContext(ctx).Create(p).Error
+}
+
+// PayoutFilter เงื่อนไขของ ListPayouts (ค่าว่าง = ไม่กรอง)
+type PayoutFilter struct {
+	OwnerID string
+	Status  string
+}
+
+func (r *PaymentRepo) ListPayouts(ctx context.Context, page, size int32, f PayoutFilter) ([]domain.Payout, int64, error) {
+	limit, offset := paging(page, size)
+	qb := r.db.WithContext(ctx).Model(&domain.Payout{})
+	if f.OwnerID != "" {
+		qb = qb.Where("owner_id = ?", f.OwnerID)
+	}
+	if f.Status != "" {
+		qb = qb.Where("status = ?", f.Status)
+	}
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var out []domain.Payout
+	if err := qb.Order("period_start DESC, owner_id").Limit(limit).Offset(offset).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+// Payout ใบสรุปพร้อม lines
+func (r *PaymentRepo) Payout(ctx context.Context, id string) (*domain.Payout, error) {
+	var p domain.Payout
+	err := r.db.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("occurred_at ASC") }).
+		First(&p, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// MarkPayoutPaid PENDING -> PAID; false = ไม่พบหรือจ่ายไปแล้ว
+func (r *PaymentRepo) MarkPayoutPaid(ctx context.Context, id, ref string, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&domain.Payout{}).
+		Where("id = ? AND status = ?", id, domain.PayoutPending).
+		Updates(map[string]any{"status": domain.PayoutPaid, "paid_at": at, "paid_ref": ref})
+	return res.RowsAffected > 0, res.Error
+}
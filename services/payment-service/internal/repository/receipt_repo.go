@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// ReceiptByCharge ใบเสร็จของ charge (ไม่มี = gorm.ErrRecordNotFound)
+func (r *PaymentRepo) ReceiptByCharge(ctx context.Context, chargeID string) (*domain.Receipt, error) {
+	var out domain.Receipt
+	if err := r.db.WithContext(ctx).First(&out, "charge_id = ?", chargeID).Error; err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateReceipt ออกเลขถัดไปของ series ให้ rc แล้ว render PDF และบันทึกใน transaction เดียว
+// (เลขไม่ข้ามเพราะ render พังก็ rollback) charge เดิมมีใบแล้ว = คืนใบเดิมใน rc และ created = false
+func (r *PaymentRepo) CreateReceipt(ctx context.Context, rc *domain.Receipt, series string, render func(*domain.Receipt) ([]byte, error)) (created bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&domain.ReceiptSequence{Series: series}).Error; err != nil {
+			return err
+		}
+		var seq domain.ReceiptSequence
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&seq, "series = ?", series).Error; err != nil {
+			return err
+		}
+		// เช็คหลังได้ lock: event ซ้ำที่มาพร้อมกันจะเห็นใบของอีกตัว
+		var prev domain.Receipt
+		err := tx.First(&prev, "charge_id = ?", rc.ChargeID).Error
+		if err == nil {
+			*rc = prev
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		seq.Last++
+		if err := tx.Save(&seq).Error; err != nil {
+			return err
+		}
+		rc.Number = fmt.Sprintf("%s-%06d", series, seq.Last)
+		if rc.PDF, err = render(rc); err != nil {
+			return fmt.Errorf("render receipt %s: %w", rc.Number, err)
+		}
+		if err := tx.Create(rc).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// CreatedBetween แถวใน ledger ที่สร้างในช่วง [from, to)
+func (r *PaymentRepo) CreatedBetween(ctx context.Context, from, to time.Time) ([]domain.Payment, error) {
+	var out []domain.Payment
+	err := r.db.WithContext(ctx).Where("created_at >= ? AND created_at < ?", from, to).Find(&out).Error
+	return out, err
+}
+
+// PendingBetween แถวใน ledger ที่ยัง pending และสร้างในช่วง [from, to) (ไม่รวม wallet ที่ไม่มีที่ gateway)
+func (r *PaymentRepo) PendingBetween(ctx context.Context, from, to time.Time) ([]domain.Payment, error) {
+	var out []domain.Payment
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND method <> ? AND created_at >= ? AND created_at < ?", "pending", domain.MethodWallet, from, to).
+		Find(&out).Error
+	return out, err
+}
+
+// ByCharges แถวใน ledger ของ charge ids (key = charge id)
+func (r *PaymentRepo) ByCharges(ctx context.Context, ids []string) (map[string]domain.Payment, error) {
+	out := make(map[string]domain.Payment, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var list []domain.Payment
+	if err := r.db.WithContext(ctx).Where("charge_id IN ?", ids).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range list {
+		out[p.ChargeID] = p
+	}
+	return out, nil
+}
+
+// SaveRun บันทึกรอบพร้อม items
+func (r *PaymentRepo) SaveRun(ctx context.Context, run *domain.ReconciliationRun) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+// LastRun รอบล่าสุดที่ list จาก gateway สำเร็จ
+func (r *PaymentRepo) LastRun(ctx context.Context) (*domain.ReconciliationRun, error) {
+	var run domain.ReconciliationRun
+	if err := r.db.WithContext(ctx).Where("error = ?", "").Order("started_at DESC").First(&run).Error; err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// Run รอบตาม id (ว่าง = รอบล่าสุด) พร้อม items
+func (r *PaymentRepo) Run(ctx context.Context, id string) (*domain.ReconciliationRun, error) {
+	var run domain.ReconciliationRun
+	q := r.db.WithContext(ctx).Preload("Items")
+	if id != "" {
+		q = q.Where("id = ?", id)
+	} else {
+		q = q.Order("started_at DESC")
+	}
+	if err := q.First(&run).Error; err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
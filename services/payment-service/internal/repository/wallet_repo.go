@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+var (
+	ErrInsufficientFunds = errors.New("insufficient wallet balance")
+	ErrWalletCurrency    = errors.New("wallet currency mismatch")
+)
+
+// Wallet ยอดคงเหลือของ userID (ยังไม่เคยเติม = ยอด 0)
+func (r *PaymentRepo) Wallet(ctx context.Context, userID string) (*domain.Wallet, error) {
+	var w domain.Wallet
+	err := r.db.WithContext(ctx).First(&w, "user_id = ?", userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &domain.Wallet{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// applyWallet ล็อก wallet แล้วบวก wt.Amount (ลบ = ตัดเงิน) พร้อมบันทึกรายการ
+// key ซ้ำ = เคยทำแล้ว คืนรายการเดิมใน wt และ created = false
+func applyWallet(tx *gorm.DB, wt *domain.WalletTransaction) (created bool, err error) {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.Wallet{UserID: wt.UserID, Currency: wt.Currency}).Error; err != nil {
+		return false, err
+	}
+	var w domain.Wallet
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&w, "user_id = ?", wt.UserID).Error; err != nil {
+		return false, err
+	}
+	// เช็ค key หลังได้ lock: คำขอซ้ำที่มาพร้อมกันจะเห็นรายการของอีกตัว
+	var prev domain.WalletTransaction
+	err = tx.First(&prev, "key = ?", wt.Key).Error
+	if err == nil {
+		*wt = prev
+		return false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	if w.Currency != "" && !strings.EqualFold(w.Currency, wt.Currency) {
+		return false, fmt.Errorf("%w: wallet is %s", ErrWalletCurrency, w.Currency)
+	}
+	if w.Balance+wt.Amount < 0 {
+		return false, fmt.Errorf("%w: balance %d, need %d", ErrInsufficientFunds, w.Balance, -wt.Amount)
+	}
+	w.Balance += wt.Amount
+	if w.Currency == "" {
+		w.Currency = wt.Currency
+	}
+	if err := tx.Save(&w).Error; err != nil {
+		return false, err
+	}
+	wt.BalanceAfter = w.Balance
+	if err := tx.Create(wt).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WalletTopUp เพิ่มเงินจาก charge เติมเงินที่สำเร็จแล้ว (ครั้งเดียวต่อ key)
+func (r *PaymentRepo) WalletTopUp(ctx context.Context, wt *domain.WalletTransaction) (created bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, err = applyWallet(tx, wt)
+		return err
+	})
+	return created, err
+}
+
+// WalletPay ตัดเงินค่า booking และบันทึก p ลง ledger พร้อม evs (payment.paid) ใน txn เดียวกัน
+func (r *PaymentRepo) WalletPay(ctx context.Context, wt *domain.WalletTransaction, p *domain.Payment, evs ...outbox.Event) (created bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, err = applyWallet(tx, wt)
+		if err != nil || !created {
+			return err
+		}
+		if err := tx.Create(p).Error; err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, evs...)
+	})
+	return created, err
+}
+
+// WalletRefund คืนเงินเข้า wallet พร้อมบันทึก rf, ยอดคืนของ payment และ evs ใน txn เดียวกัน
+func (r *PaymentRepo) WalletRefund(ctx context.Context, wt *domain.WalletTransaction, rf *domain.Refund, evs ...outbox.Event) (created bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, err = applyWallet(tx, wt)
+		if err != nil || !created {
+			return err
+		}
+		if err := tx.Create(rf).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Payment{}).Where("charge_id = ?", rf.ChargeID).
+			Update("refunded_amount", gorm.Expr("refunded_amount + ?", rf.Amount)).Error; err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, evs...)
+	})
+	return created, err
+}
+
+// WalletTransaction รายการ wallet ตาม id (gorm.ErrRecordNotFound = ไม่มี)
+func (r *PaymentRepo) WalletTransaction(ctx context.Context, id string) (*domain.WalletTransaction, error) {
+	var wt domain.WalletTransaction
+	if err := r.db.WithContext(ctx).First(&wt, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &wt, nil
+}
+
+func (r *PaymentRepo) WalletTransactions(ctx context.Context, userID string, page, size int32) ([]domain.WalletTransaction, int64, error) {
+	limit, offset := paging(page, size)
+	qb := r.db.WithContext(ctx).Model(&domain.WalletTransaction{}).Where("user_id = ?", userID)
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var out []domain.WalletTransaction
+	if err := qb.Order("created_at DESC").Limit(limit).Offset(offset).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// webhook_events: กันซ้ำ + คิวงานของ webhook worker (อยู่ใน DB เดียวกับ ledger)
+
+// SaveWebhookEvent บันทึก event id ใหม่เป็น pending; created = false คือเคยรับแล้ว
+func (r *PaymentRepo) SaveWebhookEvent(ctx context.Context, id string) (created bool, err error) {
+	now := time.Now().UTC()
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.WebhookEvent{ID: id, Status: domain.WebhookPending, NextAttemptAt: now})
+	return res.RowsAffected == 1, res.Error
+}
+
+// ClaimWebhookEvent ให้ worker เดียวได้ event ที่ถึงเวลาไป (กันหลาย worker/instance ทำซ้ำ)
+func (r *PaymentRepo) ClaimWebhookEvent(ctx context.Context, id string) (*domain.WebhookEvent, error) {
+	now := time.Now().UTC()
+	res := r.db.WithContext(ctx).Model(&domain.WebhookEvent{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, domain.WebhookPending, now).
+		Updates(map[string]any{"status": domain.WebhookProcessing, "attempts": gorm.Expr("attempts + 1"), "updated_at": now})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return nil, res.Error
+	}
+	var ev domain.WebhookEvent
+	if err := r.db.WithContext(ctx).First(&ev, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &ev, nil
+}
+
+func (r *PaymentRepo) FinishWebhookEvent(ctx context.Context, id, key string) error {
+	return r.db.WithContext(ctx).Model(&domain.WebhookEvent{}).Where("id = ?", id).
+		Updates(map[string]any{"status": domain.WebhookDone, "key": key, "last_error": ""}).Error
+}
+
+// RetryWebhookEvent next ศูนย์ = เลิกลองแล้ว (failed)
+func (r *PaymentRepo) RetryWebhookEvent(ctx context.Context, id string, cause error, next time.Time) error {
+	st := domain.WebhookPending
+	if next.IsZero() {
+		st = domain.WebhookFailed
+	}
+	return r.db.WithContext(ctx).Model(&domain.WebhookEvent{}).Where("id = ?", id).
+		Updates(map[string]any{"status": st, "last_error": cause.Error(), "next_attempt_at": next}).Error
+}
+
+// DueWebhookEvents event ที่ถึงเวลาทำ (ใหม่ที่หลุดคิว / รอ retry)
+func (r *PaymentRepo) DueWebhookEvents(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&domain.WebhookEvent{}).
+		Where("status = ? AND next_attempt_at <= ?", domain.WebhookPending, now).
+		Order("next_attempt_at ASC").Limit(limit).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ResetStuckWebhookEvents processing ที่ค้างนานเกิน (worker ตายกลางทาง) กลับเป็น pending
+func (r *PaymentRepo) ResetStuckWebhookEvents(ctx context.Context, before time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.WebhookEvent{}).
+		Where("status = ? AND updated_at < ?", domain.WebhookProcessing, before).
+		Updates(map[string]any{"status": domain.WebhookPending, "next_attempt_at": time.Now().UTC()}).Error
+}
+
+// PruneWebhookEvents ลบ event ที่ทำเสร็จแล้วเก่ากว่า before (พ้นช่วงที่ gateway จะส่งซ้ำ)
+func (r *PaymentRepo) PruneWebhookEvents(ctx context.Context, before time.Time) error {
+	return r.db.WithContext(ctx).Where("status = ? AND updated_at < ?", domain.WebhookDone, before).
+		Delete(&domain.WebhookEvent{}).Error
+}
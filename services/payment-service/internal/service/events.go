@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omise/omise-go"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// payment.* events ทั้งหมดเขียนผ่าน outbox ใน txn เดียวกับ ledger (relay ใน cmd/payment เป็นคน publish)
+// payload เป็น events.Envelope ตามสัญญาใน pkg/events; ctx ใช้แนบ trace context
+
+func envelope[T any](ctx context.Context, key string, data T) outbox.Event {
+	return outbox.Event{Key: key, Payload: events.New(ctx, key, data)}
+}
+
+// paidEvent payment.paid ของ charge ที่สำเร็จ (booking_id/share_id จาก metadata)
+func paidEvent(ctx context.Context, ch *omise.Charge) outbox.Event {
+	bookingID, _ := ch.Metadata["booking_id"].(string)
+	return envelope(ctx, events.RKPaymentPaid, events.PaymentPaid{
+		PaymentID: ch.ID,
+		BookingID: bookingID,
+		ShareID:   ShareOf(ch),
+		Amount:    ch.Amount,
+		Currency:  ch.Currency,
+		Method:    ChargeMethod(ch),
+	})
+}
+
+// failedEvent payment.failed ของ charge ที่ล้มเหลว/หมดอายุ
+func failedEvent(ctx context.Context, ch *omise.Charge) outbox.Event {
+	bookingID, _ := ch.Metadata["booking_id"].(string)
+	fc, fm := failureOf(ch)
+	return envelope(ctx, events.RKPaymentFailed, events.PaymentFailed{
+		PaymentID:      ch.ID,
+		BookingID:      bookingID,
+		ShareID:        ShareOf(ch),
+		FailureCode:    fc,
+		FailureMessage: fm,
+	})
+}
+
+// attemptFailedEvent payment.failed ตอนสร้าง charge ไม่สำเร็จ (ไม่มี payment_id; booking ไม่นับเป็นความล้มเหลว)
+func attemptFailedEvent(ctx context.Context, bookingID, shareID string, cause error) outbox.Event {
+	return envelope(ctx, events.RKPaymentFailed, events.PaymentFailed{
+		BookingID:      bookingID,
+		ShareID:        shareID,
+		FailureCode:    "create_charge_error",
+		FailureMessage: cause.Error(),
+	})
+}
+
+func refundedEvent(ctx context.Context, rf *domain.Refund) outbox.Event {
+	return envelope(ctx, events.RKPaymentRefunded, events.PaymentRefunded{
+		RefundID:  rf.ID,
+		PaymentID: rf.ChargeID,
+		BookingID: rf.BookingID,
+		Amount:    rf.Amount,
+		Currency:  rf.Currency,
+		Reason:    rf.Reason,
+	})
+}
+
+// ChargeEvents payment.paid / payment.failed ตามผลสุดท้ายของ charge ค่าจอง
+// ยังไม่จบ (pending/awaiting_authorize) หรือเป็น charge เติม wallet = ไม่มี event
+func ChargeEvents(ctx context.Context, ch *omise.Charge) []outbox.Event {
+	if WalletUserOf(ch) != "" {
+		return nil
+	}
+	switch ch.Status {
+	case omise.ChargeSuccessful:
+		return []outbox.Event{paidEvent(ctx, ch)}
+	case omise.ChargeFailed, "expired": // SDK ไม่มีค่าคงที่ของ expired
+		return []outbox.Event{failedEvent(ctx, ch)}
+	}
+	return nil
+}
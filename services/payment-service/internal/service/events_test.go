@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/omise/omise-go"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// decodeOne ผ่าน outbox แบบ relay (json.Marshal ของ Payload) แล้วอ่านด้วย events.Decode[T] แบบ consumer
+func decodeOne[T any](t *testing.T, evs []outbox.Event, key string) T {
+	t.Helper()
+	if len(evs) != 1 {
+		t.Fatalf("got %d events, want 1", len(evs))
+	}
+	if evs[0].Key != key {
+		t.Fatalf("key = %s, want %s", evs[0].Key, key)
+	}
+	body, err := json.Marshal(evs[0].Payload)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	ev, err := events.Decode[T](body)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if ev.Type != key {
+		t.Fatalf("envelope type = %s, want %s", ev.Type, key)
+	}
+	return ev.Data
+}
+
+func testCharge(st omise.ChargeStatus, meta map[string]any) *omise.Charge {
+	ch := &omise.Charge{Amount: 20000, Currency: "thb", Status: st, Metadata: meta}
+	ch.ID = "chrg_1"
+	return ch
+}
+
+func TestPaymentEventContracts(t *testing.T) {
+	ctx := context.Background()
+	code, msg := "insufficient_fund", "no money"
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{"paid", func(t *testing.T) {
+			ch := testCharge(omise.ChargeSuccessful, chargeMeta("b1", ""))
+			ch.Source = &omise.Source{Type: "promptpay"}
+			got := decodeOne[events.PaymentPaid](t, ChargeEvents(ctx, ch), events.RKPaymentPaid)
+			want := events.PaymentPaid{PaymentID: "chrg_1", BookingID: "b1", Amount: 20000, Currency: "thb", Method: "promptpay"}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"paid share", func(t *testing.T) {
+			ch := testCharge(omise.ChargeSuccessful, chargeMeta("b1", "sh1"))
+			got := decodeOne[events.PaymentPaid](t, []outbox.Event{paidEvent(ctx, ch)}, events.RKPaymentPaid)
+			if got.ShareID != "sh1" || got.Method != "card" {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"failed", func(t *testing.T) {
+			ch := testCharge(omise.ChargeFailed, chargeMeta("b1", ""))
+			ch.FailureCode, ch.FailureMessage = &code, &msg
+			got := decodeOne[events.PaymentFailed](t, ChargeEvents(ctx, ch), events.RKPaymentFailed)
+			want := events.PaymentFailed{PaymentID: "chrg_1", BookingID: "b1", FailureCode: code, FailureMessage: msg}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"expired", func(t *testing.T) {
+			ch := testCharge("expired", chargeMeta("b1", "sh1"))
+			got := decodeOne[events.PaymentFailed](t, ChargeEvents(ctx, ch), events.RKPaymentFailed)
+			if got.PaymentID != "chrg_1" || got.ShareID != "sh1" {
+				t.Fatalf("got %+v", got)
+			}
+		}},
+		{"pending has no event", func(t *testing.T) {
+			if evs := ChargeEvents(ctx, testCharge(omise.ChargePending, chargeMeta("b1", ""))); len(evs) != 0 {
+				t.Fatalf("got %d events", len(evs))
+			}
+		}},
+		{"wallet top-up has no event", func(t *testing.T) {
+			ch := testCharge(omise.ChargeSuccessful, map[string]any{metaWalletUser: "u1"})
+			if evs := ChargeEvents(ctx, ch); len(evs) != 0 {
+				t.Fatalf("got %d events", len(evs))
+			}
+		}},
+		{"attempt failed", func(t *testing.T) {
+			evs := []outbox.Event{attemptFailedEvent(ctx, "b1", "sh1", errors.New("network down"))}
+			got := decodeOne[events.PaymentFailed](t, evs, events.RKPaymentFailed)
+			want := events.PaymentFailed{BookingID: "b1", ShareID: "sh1", FailureCode: "create_charge_error", FailureMessage: "network down"}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+		{"refunded", func(t *testing.T) {
+			rf := &domain.Refund{ID: "rfnd_1", ChargeID: "chrg_1", BookingID: "b1", Amount: 10000, Currency: "thb", Reason: "cancelled"}
+			got := decodeOne[events.PaymentRefunded](t, []outbox.Event{refundedEvent(ctx, rf)}, events.RKPaymentRefunded)
+			want := events.PaymentRefunded{RefundID: "rfnd_1", PaymentID: "chrg_1", BookingID: "b1", Amount: 10000, Currency: "thb", Reason: "cancelled"}
+			if got != want {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
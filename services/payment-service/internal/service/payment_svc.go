@@ -6,159 +6,347 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
-	"net/url"
-	"strconv"
+	"log"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/omise/omise-go"
-	"github.com/omise/omise-go/operations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
-	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/outbox"
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+	"github.com/you/badminton-booking/services/payment-service/internal/provider"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
 )
 
+// PaymentSvc ไม่ publish เอง: payment.* events ถูกเขียนลง outbox ใน txn เดียวกับ ledger (ดู events.go)
 type PaymentSvc struct {
-	omc       *omise.Client
-	secretKey string // ใช้ยิง REST (Basic Auth) -> skey_xxx
-	pub       *mq.Publisher
+	prov    provider.PaymentProvider       // Omise จริง หรือ fake (PAYMENT_PROVIDER)
+	booking bookingv1.BookingServiceClient // ราคาที่ถูกต้องของ booking (QuoteBooking)
+	repo    *repository.PaymentRepo        // ledger ของทุก charge (ตาราง payments)
+	hub     chargeHub                      // ผู้ที่ Watch charge อยู่
 }
 
-func NewPaymentSvc(omc *omise.Client, secretKey string, pub *mq.Publisher) *PaymentSvc {
-	return &PaymentSvc{omc: omc, secretKey: secretKey, pub: pub}
+func NewPaymentSvc(prov provider.PaymentProvider, booking bookingv1.BookingServiceClient, repo *repository.PaymentRepo) *PaymentSvc {
+	return &PaymentSvc{prov: prov, booking: booking, repo: repo}
 }
 
-// ---------- helpers (publish events) ----------
-func (s *PaymentSvc) publishPaid(ctx context.Context, bookingID, chargeID string, amount int64, currency string) {
-	if s.pub == nil {
-		return
+// CheckAmount ให้ยอดที่ขอ charge ตรงกับราคาที่ booking-service คำนวณไว้เท่านั้น
+// booking ที่หารจ่ายต้องจ่ายทีละส่วน (shareID) ตามยอดของส่วนนั้น
+func (s *PaymentSvc) CheckAmount(ctx context.Context, bookingID, shareID string, amount int64, currency string) error {
+	if bookingID == "" {
+		return status.Error(codes.InvalidArgument, "booking_id is required")
 	}
-	_ = s.pub.PublishJSON(ctx, "payment.paid", map[string]any{
-		"booking_id": bookingID,
-		"charge_id":  chargeID,
-		"amount":     amount,
-		"currency":   currency,
-	})
+	res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+	if err != nil {
+		return err
+	}
+	if shareID != "" || splitting(res) {
+		return checkShare(res, shareID, amount, currency)
+	}
+	q, err := s.booking.QuoteBooking(ctx, &bookingv1.QuoteBookingRequest{BookingId: bookingID})
+	if err != nil {
+		return err
+	}
+	if amount != q.Amount || !strings.EqualFold(currency, q.Currency) {
+		return status.Errorf(codes.InvalidArgument, "amount mismatch: booking %s costs %d %s", bookingID, q.Amount, q.Currency)
+	}
+	return nil
 }
 
-func (s *PaymentSvc) publishFailed(ctx context.Context, bookingID, chargeID, code, message string) {
-	if s.pub == nil {
-		return
+// ownerOf user id เจ้าของ booking สำหรับ ledger; หาไม่ได้ก็ปล่อยว่าง (ไม่ขวางการจ่าย)
+func (s *PaymentSvc) ownerOf(ctx context.Context, bookingID string) string {
+	res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+	if err != nil {
+		log.Printf("[payment] lookup owner of booking %s: %v", bookingID, err)
+		return ""
+	}
+	return res.GetBooking().GetUserId()
+}
+
+// ---------- Idempotency ----------
+
+// keyClaimTTL Idempotency-Key ที่ยังไม่มี charge และค้างนานกว่านี้ถือว่าคำขอเดิมตายกลางทาง (นานกว่า timeout ของ gateway มาก)
+const keyClaimTTL = 5 * time.Minute
+
+// payLockTTL เวลาสูงสุดที่คำขอหนึ่งถือ lock การจ่ายของ booking/ส่วนหนึ่ง
+const payLockTTL = time.Minute
+
+// lockPayment ให้การจ่าย booking (หรือส่วน shareID) ทำได้ทีละคำขอ
+// ensureUnpaid ไม่ได้ล็อกแถว: ต้องเรียกใต้ lock นี้และบันทึก ledger ก่อน unlock คำขอถัดไปจึงเห็น charge ที่สำเร็จ
+func (s *PaymentSvc) lockPayment(ctx context.Context, bookingID, shareID string) (unlock func(), err error) {
+	target := "booking:" + bookingID
+	if shareID != "" {
+		target = "share:" + shareID
+	}
+	token := uuid.NewString()
+	ok, err := s.repo.LockPayment(ctx, target, token, payLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.Aborted, "another payment for this booking is in progress")
+	}
+	return func() {
+		if err := s.repo.UnlockPayment(context.WithoutCancel(ctx), target, token); err != nil {
+			log.Printf("[payment] unlock %s: %v", target, err)
+		}
+	}, nil
+}
+
+// ensureUnpaid booking (หรือส่วน shareID) ที่มี charge สำเร็จแล้วห้ามจ่ายซ้ำ (codes.AlreadyExists)
+func (s *PaymentSvc) ensureUnpaid(ctx context.Context, bookingID, shareID string) error {
+	if shareID != "" {
+		p, err := s.repo.SuccessfulByShare(ctx, shareID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return status.Errorf(codes.AlreadyExists, "share %s is already paid (charge %s)", shareID, p.ChargeID)
+	}
+	p, err := s.repo.SuccessfulByBooking(ctx, bookingID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return status.Errorf(codes.AlreadyExists, "booking %s is already paid (charge %s)", bookingID, p.ChargeID)
+}
+
+// idempotent เรียก create ครั้งเดียวต่อ key ของผู้เรียก userID: คำขอซ้ำได้ charge เดิม (สถานะล่าสุดจาก gateway)
+// create ล้มเหลว = ปล่อย key ให้ลองใหม่ได้
+func (s *PaymentSvc) idempotent(ctx context.Context, userID, key, bookingID string, create func() (*omise.Charge, error)) (*omise.Charge, error) {
+	if key == "" {
+		return create()
+	}
+	if userID != "" {
+		key = userID + ":" + key
+	}
+	claimed, prev, err := s.repo.ClaimKey(ctx, key, bookingID, keyClaimTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		switch {
+		case prev.BookingID != bookingID:
+			return nil, status.Error(codes.InvalidArgument, "idempotency key was used for a different booking")
+		case prev.ChargeID == "":
+			return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+		}
+		return s.prov.RetrieveCharge(ctx, prev.ChargeID)
+	}
+
+	ch, err := create()
+	if err != nil {
+		if rerr := s.repo.ReleaseKey(context.WithoutCancel(ctx), key); rerr != nil {
+			log.Printf("[payment] release idempotency key %s: %v", key, rerr)
+		}
+		return nil, err
+	}
+	if err := s.repo.CompleteKey(context.WithoutCancel(ctx), key, ch.ID); err != nil {
+		log.Printf("[payment] complete idempotency key %s: %v", key, err)
+	}
+	return ch, nil
+}
+
+// ---------- Ledger ----------
+
+// RecordCharge บันทึกสถานะล่าสุดของ charge ลงตาราง payments (booking_id มาจาก metadata ของ charge)
+// พร้อม evs ลง outbox ใน txn เดียวกัน (เช่น ChargeEvents)
+func (s *PaymentSvc) RecordCharge(ctx context.Context, userID string, ch *omise.Charge, evs ...outbox.Event) error {
+	raw, err := json.Marshal(ch)
+	if err != nil {
+		return err
+	}
+	bookingID, _ := ch.Metadata["booking_id"].(string)
+	fc, fm := failureOf(ch)
+	p := &domain.Payment{
+		ChargeID:       ch.ID,
+		BookingID:      bookingID,
+		ShareID:        ShareOf(ch),
+		UserID:         userID,
+		Amount:         ch.Amount,
+		Currency:       ch.Currency,
+		Method:         ChargeMethod(ch),
+		Status:         string(ch.Status),
+		FailureCode:    fc,
+		FailureMessage: fm,
+		Raw:            string(raw),
+	}
+	if ch.Status == omise.ChargeSuccessful {
+		now := time.Now().UTC()
+		p.PaidAt = &now // แถวที่มี paid_at แล้วเก็บค่าเดิม (upsertPayment)
+	}
+	if err := s.repo.Upsert(ctx, p, evs...); err != nil {
+		return err
+	}
+	s.hub.publish(*p)
+	return nil
+}
+
+// record ใช้หลังสร้าง charge: charge เกิดที่ gateway แล้ว ถ้าเขียน ledger ไม่ได้ก็แค่ log
+// (webhook/reconciler จะเขียน ledger และ event ซ้ำให้)
+func (s *PaymentSvc) record(ctx context.Context, userID string, ch *omise.Charge, evs ...outbox.Event) {
+	if err := s.RecordCharge(context.WithoutCancel(ctx), userID, ch, evs...); err != nil {
+		log.Printf("[payment] record charge %s: %v", ch.ID, err)
+	}
+}
+
+func (s *PaymentSvc) ListPayments(ctx context.Context, page, size int32, f repository.ListFilter) ([]domain.Payment, int64, error) {
+	return s.repo.List(ctx, page, size, f)
+}
+
+func (s *PaymentSvc) PaymentsByBooking(ctx context.Context, bookingID string) ([]domain.Payment, error) {
+	if bookingID == "" {
+		return nil, status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+	return s.repo.ByBooking(ctx, bookingID)
+}
+
+// ---------- helpers ----------
+
+// ChargeMethod วิธีชำระของ charge: source type (เช่น promptpay) หรือ card
+func ChargeMethod(ch *omise.Charge) string {
+	if ch.Source != nil && ch.Source.Type != "" {
+		return ch.Source.Type
+	}
+	return "card"
+}
+
+// failureOf FailureCode/FailureMessage ของ omise เป็น *string
+func failureOf(ch *omise.Charge) (code, message string) {
+	if ch.FailureCode != nil {
+		code = *ch.FailureCode
+	}
+	if ch.FailureMessage != nil {
+		message = *ch.FailureMessage
+	}
+	return code, message
+}
+
+// enqueue เขียน event ที่ไม่มีแถว ledger คู่กัน; ไม่สำเร็จแค่ log
+func (s *PaymentSvc) enqueue(ctx context.Context, evs ...outbox.Event) {
+	if err := s.repo.Enqueue(context.WithoutCancel(ctx), evs...); err != nil {
+		log.Printf("[payment] enqueue %d event(s): %v", len(evs), err)
 	}
-	_ = s.pub.PublishJSON(ctx, "payment.failed", map[string]any{
-		"booking_id":      bookingID,
-		"charge_id":       chargeID,
-		"failure_code":    code,
-		"failure_message": message,
-	})
 }
 
 // ---------- Card ----------
 type CreateCardChargeInput struct {
-	BookingID string
-	Amount    int64
-	Currency  string
-	CardToken string
+	BookingID      string
+	ShareID        string // ส่วนของ booking หารจ่าย (ว่าง = จ่ายทั้ง booking)
+	Amount         int64
+	Currency       string
+	CardToken      string
+	IdempotencyKey string // ว่าง = ไม่กันซ้ำ
+	CallerID       string // ผู้เรียก (x-user-id); IdempotencyKey แยกตามผู้ใช้
 }
 
-func (s *PaymentSvc) CreateCardCharge(in CreateCardChargeInput) (*omise.Charge, error) {
+func (s *PaymentSvc) CreateCardCharge(ctx context.Context, in CreateCardChargeInput) (*omise.Charge, error) {
 	if in.Amount <= 0 || in.CardToken == "" || in.Currency == "" {
 		return nil, errors.New("invalid params")
 	}
-	ch := &omise.Charge{}
-	req := &operations.CreateCharge{
+	return s.idempotent(ctx, in.CallerID, in.IdempotencyKey, in.BookingID, func() (*omise.Charge, error) {
+		return s.createCardCharge(ctx, in)
+	})
+}
+
+func (s *PaymentSvc) createCardCharge(ctx context.Context, in CreateCardChargeInput) (*omise.Charge, error) {
+	if err := s.CheckAmount(ctx, in.BookingID, in.ShareID, in.Amount, in.Currency); err != nil {
+		return nil, err
+	}
+	unlock, err := s.lockPayment(ctx, in.BookingID, in.ShareID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := s.ensureUnpaid(ctx, in.BookingID, in.ShareID); err != nil {
+		return nil, err
+	}
+	userID := s.payerOf(ctx, in.BookingID, in.ShareID)
+	ch, err := s.prov.CreateCharge(ctx, provider.ChargeRequest{
 		Amount:   in.Amount,
 		Currency: in.Currency,
 		Card:     in.CardToken,
-		Metadata: map[string]any{"booking_id": in.BookingID},
-	}
-
-	if err := s.omc.Do(ch, req); err != nil {
-		// publish failed without charge_id (ยังสร้าง charge ไม่สำเร็จ)
-		s.publishFailed(context.Background(), in.BookingID, "", "create_charge_error", err.Error())
+		Metadata: chargeMeta(in.BookingID, in.ShareID),
+	})
+	if err != nil {
+		// failed without charge_id (ยังสร้าง charge ไม่สำเร็จ)
+		s.enqueue(ctx, attemptFailedEvent(ctx, in.BookingID, in.ShareID, err))
 		return nil, err
 	}
-
-	fmt.Printf("Payment status %s\n", string(ch.Status))
-
 	// สถานะจาก Omise: pending / successful / failed / awaiting_authorize
-	switch string(ch.Status) {
-	case "successful":
-		s.publishPaid(context.Background(), in.BookingID, ch.ID, ch.Amount, ch.Currency)
-	case "failed":
-		var fc, fm string
-		if ch.FailureCode != nil {
-			fc = *ch.FailureCode
-		}
-		if ch.FailureMessage != nil {
-			fm = *ch.FailureMessage
-		}
-		s.publishFailed(context.Background(), in.BookingID, ch.ID, fc, fm)
-		// pending/awaiting_authorize ไม่ยิง paid/failed ณ จุดนี้ (รอ webhook ยืนยันผลสุดท้าย)
-	}
-
+	// pending/awaiting_authorize ไม่มี paid/failed ณ จุดนี้ (รอ webhook ยืนยันผลสุดท้าย)
+	s.record(ctx, userID, ch, ChargeEvents(ctx, ch)...)
 	return ch, nil
 }
 
 // ---------- Source (ใช้ source_id ตรง ๆ) ----------
 type CreateChargeWithSourceIDInput struct {
-	BookingID string
-	Amount    int64
-	Currency  string
-	SourceID  string
+	BookingID      string
+	ShareID        string // ส่วนของ booking หารจ่าย (ว่าง = จ่ายทั้ง booking)
+	Amount         int64
+	Currency       string
+	SourceID       string
+	IdempotencyKey string // ว่าง = ไม่กันซ้ำ
+	CallerID       string // ผู้เรียก (x-user-id); IdempotencyKey แยกตามผู้ใช้
 }
 
-func (s *PaymentSvc) CreateChargeWithSourceID(in CreateChargeWithSourceIDInput) (*omise.Charge, error) {
+func (s *PaymentSvc) CreateChargeWithSourceID(ctx context.Context, in CreateChargeWithSourceIDInput) (*omise.Charge, error) {
 	if in.Amount <= 0 || in.Currency == "" || in.SourceID == "" {
 		return nil, errors.New("invalid params")
 	}
-	ch := &omise.Charge{}
-	req := &operations.CreateCharge{
+	return s.idempotent(ctx, in.CallerID, in.IdempotencyKey, in.BookingID, func() (*omise.Charge, error) {
+		return s.createChargeWithSourceID(ctx, in)
+	})
+}
+
+func (s *PaymentSvc) createChargeWithSourceID(ctx context.Context, in CreateChargeWithSourceIDInput) (*omise.Charge, error) {
+	if err := s.CheckAmount(ctx, in.BookingID, in.ShareID, in.Amount, in.Currency); err != nil {
+		return nil, err
+	}
+	unlock, err := s.lockPayment(ctx, in.BookingID, in.ShareID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := s.ensureUnpaid(ctx, in.BookingID, in.ShareID); err != nil {
+		return nil, err
+	}
+	userID := s.payerOf(ctx, in.BookingID, in.ShareID)
+	ch, err := s.prov.CreateCharge(ctx, provider.ChargeRequest{
 		Amount:   in.Amount,
 		Currency: in.Currency,
 		Source:   in.SourceID,
-		Metadata: map[string]any{"booking_id": in.BookingID},
-	}
-
-	if err := s.omc.Do(ch, req); err != nil {
-		s.publishFailed(context.Background(), in.BookingID, "", "create_charge_error", err.Error())
+		Metadata: chargeMeta(in.BookingID, in.ShareID),
+	})
+	if err != nil {
+		s.enqueue(ctx, attemptFailedEvent(ctx, in.BookingID, in.ShareID, err))
 		return nil, err
 	}
-
-	switch string(ch.Status) {
-	case "successful":
-		s.publishPaid(context.Background(), in.BookingID, ch.ID, ch.Amount, ch.Currency)
-	case "failed":
-		var fc, fm string
-		if ch.FailureCode != nil {
-			fc = *ch.FailureCode
-		}
-		if ch.FailureMessage != nil {
-			fm = *ch.FailureMessage
-		}
-		s.publishFailed(context.Background(), in.BookingID, ch.ID, fc, fm)
-	}
-
+	s.record(ctx, userID, ch, ChargeEvents(ctx, ch)...)
 	return ch, nil
 }
 
 // ---------- Source helper (ถ้า client ไม่ส่ง source_id) ----------
 
 // CreateSourceOrUseExisting: ถ้ามี source_id แล้ว => ใช้เลย; ถ้าไม่มีแต่ให้ source_type (+return_uri ถ้าจำเป็น)
-// จะสร้าง source ผ่าน SDK (promptpay) หรือ REST fallback (ช่องทางที่ต้อง return_uri)
+// จะสร้าง source ใหม่ผ่าน provider
 func (s *PaymentSvc) CreateSourceOrUseExisting(
 	ctx context.Context,
 	amount int64, currency, sourceID, sourceType, returnURI string,
 ) (*omise.Source, error) {
 
 	if sourceID != "" {
-		src := &omise.Source{}
-		// NOTE: ถ้า SDK ของคุณใช้ field ชื่อ ID ให้เปลี่ยนเป็น &operations.RetrieveSource{ID: sourceID}
-		if err := s.omc.Do(src, &operations.RetrieveSource{SourceID: sourceID}); err != nil {
-			return nil, err
-		}
-		return src, nil
+		return s.prov.RetrieveSource(ctx, sourceID)
 	}
 
 	if sourceType == "" {
@@ -167,80 +355,159 @@ func (s *PaymentSvc) CreateSourceOrUseExisting(
 	if amount <= 0 || currency == "" {
 		return nil, errors.New("invalid params")
 	}
+	return s.prov.CreateSource(ctx, provider.SourceRequest{
+		Type:      sourceType,
+		Amount:    amount,
+		Currency:  currency,
+		ReturnURI: returnURI,
+	})
+}
+
+// ---------- Retrieve ----------
+func (s *PaymentSvc) GetCharge(ctx context.Context, id string) (*omise.Charge, error) {
+	return s.prov.RetrieveCharge(ctx, id)
+}
+
+// ---------- Refund ----------
+type RefundInput struct {
+	ChargeID string
+	Amount   int64 // 0 = คืนเต็มยอดที่เหลือ
+	Reason   string
+	Key      string // กันคืนซ้ำ (ว่างได้)
+}
+
+// RejectedRefundKey key คืนเงินของ charge ที่ booking รับไม่ได้ (booking.payment_rejected)
+// reconciler ใช้ key เดียวกันจึงไม่คืนซ้ำกับ consumer
+func RejectedRefundKey(chargeID string) string {
+	return events.RKBookingPaymentRejected + ":" + chargeID
+}
+
+// refundClaimTTL แถว refund pending ที่ไม่ขยับนานกว่านี้ถือว่าคำขอเดิมตายกลางทาง ให้คำขอ key เดียวกันรับช่วงต่อ
+const refundClaimTTL = 2 * time.Minute
+
+// metaRefundKey metadata ของ refund ที่ gateway: ใช้หาว่าคืนด้วย key นี้ไปแล้วหรือยังตอนรับช่วงแถว pending
+const metaRefundKey = "refund_key"
 
-	// promptpay ไม่ต้อง return_uri -> ใช้ SDK ได้เลย
-	if strings.EqualFold(sourceType, "promptpay") {
-		src := &omise.Source{}
-		req := &operations.CreateSource{
-			Type:     sourceType,
-			Amount:   amount,
-			Currency: currency,
+// Refund คืนเงินผ่าน provider แล้วบันทึกลง ledger พร้อม payment.refunded (outbox)
+// จองแถว pending ด้วย key ก่อนเรียก gateway: key เดิมที่เคยคืนแล้ว (หรือกำลังคืน) ไม่คืนซ้ำ
+func (s *PaymentSvc) Refund(ctx context.Context, in RefundInput) (*domain.Refund, error) {
+	if in.ChargeID == "" || in.Amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, "charge_id is required and amount must be >= 0")
+	}
+	if in.Key != "" {
+		rf, err := s.repo.RefundByKey(ctx, in.Key)
+		if err == nil && rf.Status != domain.RefundPending {
+			return rf, nil
 		}
-		if err := s.omc.Do(src, req); err != nil {
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		return src, nil
 	}
-
-	// ช่องทางที่ต้อง redirect (เช่น mobile_banking_kbank / internet_banking_*)
-	return s.createSourceViaREST(ctx, sourceType, amount, currency, returnURI)
-}
-
-// createSourceViaREST: ยิง REST ไป Omise เพื่อสร้าง source ที่ต้องการ return_uri
-func (s *PaymentSvc) createSourceViaREST(
-	ctx context.Context,
-	sourceType string,
-	amount int64,
-	currency string,
-	returnURI string,
-) (*omise.Source, error) {
-	if s.secretKey == "" {
-		return nil, errors.New("missing Omise secret key for REST call")
+	// จ่ายด้วย wallet = คืนเข้า wallet (ไม่มี charge ที่ gateway)
+	if p, err := s.repo.ByCharge(ctx, in.ChargeID); err == nil && p.Method == domain.MethodWallet {
+		return s.refundToWallet(ctx, p, in)
 	}
 
-	form := url.Values{}
-	form.Set("type", sourceType)
-	form.Set("amount", strconv.FormatInt(amount, 10))
-	form.Set("currency", currency)
-	// บางช่องทางจำเป็นต้องมี return_uri (เช่น internet_banking_*, mobile_banking_*)
-	if returnURI != "" {
-		form.Set("return_uri", returnURI)
+	ch, err := s.prov.RetrieveCharge(ctx, in.ChargeID)
+	if err != nil {
+		return nil, err
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.omise.co/sources", strings.NewReader(form.Encode()))
+	if WalletUserOf(ch) != "" {
+		// คืนที่ gateway แล้วยอดยังอยู่ใน wallet = จ่ายซ้ำได้
+		return nil, status.Errorf(codes.FailedPrecondition, "charge %s is a wallet top-up and cannot be refunded", ch.ID)
+	}
+	bookingID, _ := ch.Metadata["booking_id"].(string)
+	rf := &domain.Refund{
+		ChargeID:  ch.ID,
+		BookingID: bookingID,
+		Amount:    in.Amount,
+		Currency:  ch.Currency,
+		Reason:    in.Reason,
+		Key:       in.Key,
+		Status:    domain.RefundPending,
+	}
+	if rf.Key == "" {
+		rf.Key = "refund:" + uuid.NewString()
+	}
+	rf.ID = "pending:" + rf.Key
+	claimed, prev, err := s.repo.ClaimRefund(ctx, rf, refundClaimTTL)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	// Basic Auth: username = skey_xxx, password = "" (ว่าง)
-	req.SetBasicAuth(s.secretKey, "")
+	if !claimed {
+		if prev.Status != domain.RefundPending {
+			return prev, nil
+		}
+		return nil, status.Errorf(codes.Aborted, "refund %s of charge %s is in progress", rf.Key, ch.ID)
+	}
+	if prev != nil {
+		// รับช่วงคำขอที่ตายกลางทาง: gateway อาจคืนไปแล้ว
+		rf = prev
+		if res := refundWithKey(ch, rf.Key); res != nil {
+			return s.finishRefund(ctx, rf, res)
+		}
+	}
 
-	httpClient := &http.Client{Timeout: 15 * time.Second}
-	res, err := httpClient.Do(req)
+	if err := refundable(ch, rf.Amount); err != nil {
+		if rerr := s.repo.ReleaseRefund(context.WithoutCancel(ctx), rf.Key); rerr != nil {
+			log.Printf("[payment] release refund %s: %v", rf.Key, rerr)
+		}
+		return nil, err
+	}
+	res, err := s.prov.Refund(ctx, ch.ID, rf.Amount, map[string]any{metaRefundKey: rf.Key})
 	if err != nil {
+		// gateway ตอบปฏิเสธชัดเจน = ยังไม่คืน ปล่อย key; error อื่น (timeout ฯลฯ) ไม่รู้ผล เก็บ pending ไว้ให้รับช่วงตรวจ
+		var oe *omise.Error
+		if errors.As(err, &oe) {
+			if rerr := s.repo.ReleaseRefund(context.WithoutCancel(ctx), rf.Key); rerr != nil {
+				log.Printf("[payment] release refund %s: %v", rf.Key, rerr)
+			}
+		}
 		return nil, err
 	}
-	defer res.Body.Close()
+	return s.finishRefund(ctx, rf, res)
+}
 
-	body, _ := io.ReadAll(res.Body)
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("omise create source failed: %s (%d)", string(body), res.StatusCode)
+// refundable ตรวจว่า charge คืนได้ amount (0 = ยอดที่เหลือทั้งหมด)
+func refundable(ch *omise.Charge, amount int64) error {
+	if ch.Status != omise.ChargeSuccessful {
+		return status.Errorf(codes.FailedPrecondition, "charge %s is %s, only successful charges can be refunded", ch.ID, ch.Status)
 	}
+	remaining := ch.Amount - ch.RefundedAmount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 || amount > remaining {
+		return status.Errorf(codes.FailedPrecondition, "refund amount %d exceeds refundable %d", amount, remaining)
+	}
+	return nil
+}
 
-	// ถอดรหัสเป็น omise.Source
-	var src omise.Source
-	if err := json.Unmarshal(body, &src); err != nil {
-		return nil, fmt.Errorf("parse source json failed: %w", err)
+// refundWithKey refund ของ ch ที่ส่ง key นี้ไปเป็น metadata (nil = ยังไม่เคยคืนด้วย key นี้)
+func refundWithKey(ch *omise.Charge, key string) *omise.Refund {
+	if ch.Refunds == nil {
+		return nil
+	}
+	for _, r := range ch.Refunds.Data {
+		if k, _ := r.Metadata[metaRefundKey].(string); k == key {
+			return r
+		}
 	}
-	return &src, nil
+	return nil
 }
 
-// ---------- Retrieve ----------
-func (s *PaymentSvc) GetCharge(id string) (*omise.Charge, error) {
-	ch := &omise.Charge{}
-	// NOTE: ถ้า SDK ของคุณใช้ field ชื่อ ID ให้เปลี่ยนเป็น &operations.RetrieveCharge{ID: id}
-	if err := s.omc.Do(ch, &operations.RetrieveCharge{ChargeID: id}); err != nil {
-		return nil, err
+// finishRefund ปิดแถว pending ด้วยผลจาก gateway แล้ว refresh สถานะ charge ใน ledger
+// บันทึกไม่สำเร็จ = คืน error: แถวยัง pending อยู่ คำขอ key เดิมหลัง refundClaimTTL จะเจอ refund จาก metadata แล้วบันทึกต่อโดยไม่คืนซ้ำ
+func (s *PaymentSvc) finishRefund(ctx context.Context, rf *domain.Refund, res *omise.Refund) (*domain.Refund, error) {
+	rctx := context.WithoutCancel(ctx)
+	rf.ID, rf.Amount, rf.Status = res.ID, res.Amount, domain.RefundSucceeded
+	if err := s.repo.FinishRefund(rctx, rf, refundedEvent(rctx, rf)); err != nil {
+		return nil, fmt.Errorf("record refund %s of charge %s: %w", res.ID, rf.ChargeID, err)
 	}
-	return ch, nil
+	if latest, err := s.prov.RetrieveCharge(rctx, rf.ChargeID); err == nil {
+		s.record(rctx, "", latest)
+	} else {
+		log.Printf("[payment] refresh charge %s after refund: %v", rf.ChargeID, err)
+	}
+	return rf, nil
 }
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
+)
+
+// PayoutSvc ออกใบสรุปยอดให้เจ้าของสนาม (court.owner_id) จาก ledger: ยอดจ่ายสำเร็จ - คืนเงิน - commission
+type PayoutSvc struct {
+	repo              *repository.PaymentRepo
+	booking           bookingv1.BookingServiceClient // booking -> court_id
+	courts            courtv1.CourtServiceClient     // court -> owner_id
+	commissionPercent float64
+}
+
+func NewPayoutSvc(repo *repository.PaymentRepo, booking bookingv1.BookingServiceClient, courts courtv1.CourtServiceClient, commissionPercent float64) *PayoutSvc {
+	return &PayoutSvc{repo: repo, booking: booking, courts: courts, commissionPercent: commissionPercent}
+}
+
+// Settle ออกใบสรุปของงวด [from, to) จาก charge/refund ในงวดที่ยังไม่เคยถูกนับ
+// owner ที่มีใบของงวดนี้แล้วจะถูกข้าม: รายการของเขาที่ยังไม่อยู่ในใบ (เช่น charge ที่สำเร็จหลังออกใบ) คืนเป็น skipped ให้คนตรวจ
+func (s *PayoutSvc) Settle(ctx context.Context, from, to time.Time) (out []domain.Payout, skipped []domain.PayoutLine, err error) {
+	charges, err := s.repo.UnsettledCharges(ctx, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	refunds, err := s.repo.UnsettledRefunds(ctx, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type key struct{ owner, currency string }
+	var order []key
+	payouts := map[key]*domain.Payout{}
+	add := func(owner, currency string, line domain.PayoutLine) *domain.Payout {
+		k := key{owner, currency}
+		p, ok := payouts[k]
+		if !ok {
+			p = &domain.Payout{
+				ID: uuid.NewString(), OwnerID: owner, PeriodStart: from, PeriodEnd: to,
+				Currency: currency, CommissionPercent: s.commissionPercent, Status: domain.PayoutPending,
+			}
+			payouts[k] = p
+			order = append(order, k)
+		}
+		p.Lines = append(p.Lines, line)
+		return p
+	}
+
+	lookup := newOwnerLookup(s.booking, s.courts)
+	for _, c := range charges {
+		courtID, owner, err := lookup.of(ctx, c.BookingID)
+		if err != nil {
+			log.Printf("[payout] skip charge %s: %v", c.ChargeID, err) // ลองใหม่รอบหน้า
+			continue
+		}
+		p := add(owner, c.Currency, domain.PayoutLine{
+			Kind: domain.PayoutLineCharge, RefID: c.ChargeID, ChargeID: c.ChargeID,
+			BookingID: c.BookingID, CourtID: courtID, Amount: c.Amount, OccurredAt: c.CreatedAt,
+		})
+		p.Gross += c.Amount
+	}
+	for _, rf := range refunds {
+		courtID, owner, err := lookup.of(ctx, rf.BookingID)
+		if err != nil {
+			log.Printf("[payout] skip refund %s: %v", rf.ID, err)
+			continue
+		}
+		p := add(owner, rf.Currency, domain.PayoutLine{
+			Kind: domain.PayoutLineRefund, RefID: rf.ID, ChargeID: rf.ChargeID,
+			BookingID: rf.BookingID, CourtID: courtID, Amount: rf.Amount, OccurredAt: rf.CreatedAt,
+		})
+		p.Refunds += rf.Amount
+	}
+
+	for _, k := range order {
+		p := payouts[k]
+		exists, err := s.repo.HasPayout(ctx, p.OwnerID, from, p.Currency)
+		if err != nil {
+			return out, skipped, err
+		}
+		if exists {
+			for _, l := range p.Lines {
+				log.Printf("[payout] %s %s of owner %s not in the issued statement for %s", l.Kind, l.RefID, p.OwnerID, from.Format(time.DateOnly))
+			}
+			skipped = append(skipped, p.Lines...)
+			continue
+		}
+		// commission คิดจากยอดหลังหักคืนเงิน; งวดที่คืนมากกว่าขายไม่มี commission
+		if base := p.Gross - p.Refunds; base > 0 {
+			p.Commission = int64(math.Round(float64(base) * s.commissionPercent / 100))
+		}
+		p.Net = p.Gross - p.Refunds - p.Commission
+		if err := s.repo.CreatePayout(ctx, p); err != nil {
+			return out, skipped, fmt.Errorf("create payout for owner %s: %w", p.OwnerID, err)
+		}
+		out = append(out, *p)
+	}
+	return out, skipped, nil
+}
+
+func (s *PayoutSvc) ListPayouts(ctx context.Context, page, size int32, f repository.PayoutFilter) ([]domain.Payout, int64, error) {
+	return s.repo.ListPayouts(ctx, page, size, f)
+}
+
+// PayoutStatement ใบสรุปพร้อมรายการ charge/refund
+func (s *PayoutSvc) PayoutStatement(ctx context.Context, id string) (*domain.Payout, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	p, err := s.repo.Payout(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "payout not found")
+	}
+	return p, err
+}
+
+// MarkPaid บันทึกว่าโอนให้เจ้าของสนามแล้ว (ทำได้ครั้งเดียว)
+func (s *PayoutSvc) MarkPaid(ctx context.Context, id, ref string) (*domain.Payout, error) {
+	p, err := s.PayoutStatement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.repo.MarkPayoutPaid(ctx, id, ref, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "payout is %s", p.Status)
+	}
+	return s.repo.Payout(ctx, id)
+}
+
+// ownerLookup booking -> court -> owner พร้อม cache ต่อรอบ
+type ownerLookup struct {
+	booking bookingv1.BookingServiceClient
+	courts  courtv1.CourtServiceClient
+	court   map[string]string // booking id -> court id
+	owner   map[string]string // court id -> owner id
+}
+
+func newOwnerLookup(booking bookingv1.BookingServiceClient, courts courtv1.CourtServiceClient) *ownerLookup {
+	return &ownerLookup{booking: booking, courts: courts, court: map[string]string{}, owner: map[string]string{}}
+}
+
+func (l *ownerLookup) of(ctx context.Context, bookingID string) (courtID, ownerID string, err error) {
+	if bookingID == "" {
+		return "", "", errors.New("no booking id")
+	}
+	courtID, ok := l.court[bookingID]
+	if !ok {
+		res, err := l.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+		if err != nil {
+			return "", "", fmt.Errorf("get booking %s: %w", bookingID, err)
+		}
+		courtID = res.GetBooking().GetCourtId()
+		l.court[bookingID] = courtID
+	}
+	ownerID, ok = l.owner[courtID]
+	if !ok {
+		res, err := l.courts.GetCourt(ctx, &courtv1.GetCourtRequest{Id: courtID})
+		if err != nil {
+			return "", "", fmt.Errorf("get court %s: %w", courtID, err)
+		}
+		ownerID = res.GetCourt().GetOwnerId()
+		l.owner[courtID] = ownerID
+	}
+	if ownerID == "" {
+		return "", "", fmt.Errorf("court %s has no owner", courtID)
+	}
+	return courtID, ownerID, nil
+}
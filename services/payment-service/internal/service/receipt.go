@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/omise/omise-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	courtv1 "github.com/you/badminton-booking/proto/court/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
+)
+
+// Seller ข้อมูลผู้ออกใบเสร็จ; มี TaxID (จด VAT) = ออกใบกำกับภาษีอย่างย่อพร้อมแยก VAT
+type Seller struct {
+	Name       string
+	TaxID      string
+	Address    string
+	VATPercent float64 // ราคาในระบบรวม VAT แล้ว
+}
+
+// ReceiptSvc ออกใบเสร็จของ payment ที่สำเร็จ (หนึ่งใบต่อ charge) และเก็บ PDF ไว้ใน DB
+type ReceiptSvc struct {
+	repo    *repository.PaymentRepo
+	booking bookingv1.BookingServiceClient        // เวลา/คอร์ทของ booking
+	courts  courtv1.CourtServiceClient            // ชื่อสนาม
+	render  func(*domain.Receipt) ([]byte, error) // nil = ไม่ได้ตั้ง font ออกใบใหม่ไม่ได้
+	seller  Seller
+	loc     *time.Location // ปีของเลขที่เอกสาร
+}
+
+func NewReceiptSvc(repo *repository.PaymentRepo, booking bookingv1.BookingServiceClient, courts courtv1.CourtServiceClient,
+	render func(*domain.Receipt) ([]byte, error), seller Seller, loc *time.Location) *ReceiptSvc {
+	return &ReceiptSvc{repo: repo, booking: booking, courts: courts, render: render, seller: seller, loc: loc}
+}
+
+// Get ใบเสร็จของ charge สำหรับผู้เรียก: ตรวจสิทธิ์ก่อนออกใบ (ไม่ให้คนอื่นทำให้เลขที่เอกสารถูกใช้)
+// ดูได้เฉพาะผู้จ่าย (หรือเจ้าของ booking ถ้า ledger ไม่รู้ผู้จ่าย) และ ADMIN; คนอื่นได้ NotFound เหมือนไม่มีใบ
+func (s *ReceiptSvc) Get(ctx context.Context, chargeID, callerID, role string) (*domain.Receipt, error) {
+	if chargeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "charge_id is required")
+	}
+	if callerID != "" && role != RoleAdmin {
+		p, err := s.repo.ByCharge(ctx, chargeID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "receipt not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		owner := p.UserID
+		if owner == "" && p.BookingID != "" {
+			res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: p.BookingID})
+			if err != nil {
+				return nil, fmt.Errorf("get booking %s: %w", p.BookingID, err)
+			}
+			owner = res.GetBooking().GetUserId() // แบบเดียวกับ UserID ของใบที่ Issue
+		}
+		if owner != callerID {
+			return nil, status.Error(codes.NotFound, "receipt not found")
+		}
+	}
+	return s.Issue(ctx, chargeID)
+}
+
+// Issue ออกใบเสร็จของ charge (เรียกซ้ำได้ ได้ใบเดิม) ใช้ทั้งตอน payment.paid และตอนขอดูใบที่ event ยังมาไม่ถึง
+func (s *ReceiptSvc) Issue(ctx context.Context, chargeID string) (*domain.Receipt, error) {
+	if rc, err := s.repo.ReceiptByCharge(ctx, chargeID); err == nil {
+		return rc, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	p, err := s.repo.ByCharge(ctx, chargeID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "payment not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != string(omise.ChargeSuccessful) || p.BookingID == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "no receipt for %s payment", p.Status)
+	}
+	if s.render == nil {
+		// charge/refund ยังทำงานปกติ; ใบที่ข้ามไปขอใหม่ได้ทาง GetReceipt หลังตั้ง font
+		return nil, status.Error(codes.FailedPrecondition, "receipts are disabled: PAYMENT_RECEIPT_FONT is not set")
+	}
+
+	res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: p.BookingID})
+	if err != nil {
+		return nil, fmt.Errorf("get booking %s: %w", p.BookingID, err)
+	}
+	b := res.GetBooking()
+	cres, err := s.courts.GetCourt(ctx, &courtv1.GetCourtRequest{Id: b.GetCourtId()})
+	if err != nil {
+		return nil, fmt.Errorf("get court %s: %w", b.GetCourtId(), err)
+	}
+	start, _ := time.Parse(time.RFC3339, b.GetStartIso())
+	end, _ := time.Parse(time.RFC3339, b.GetEndIso())
+
+	rc := &domain.Receipt{
+		ID:         uuid.NewString(),
+		Kind:       domain.ReceiptPlain,
+		ChargeID:   p.ChargeID,
+		BookingID:  p.BookingID,
+		UserID:     p.UserID,
+		CourtID:    b.GetCourtId(),
+		Venue:      cres.GetCourt().GetVenue(),
+		CourtNo:    cres.GetCourt().GetCourtNo(),
+		StartTime:  start,
+		EndTime:    end,
+		Amount:     p.Amount,
+		Currency:   p.Currency,
+		Method:     p.Method,
+		SellerName: s.seller.Name,
+		SellerAddr: s.seller.Address,
+		PaidAt:     p.CreatedAt, // แถวก่อนมี paid_at: created_at ไม่ขยับตามการ refresh เหมือน updated_at
+	}
+	if p.PaidAt != nil {
+		rc.PaidAt = *p.PaidAt
+	}
+	if rc.UserID == "" {
+		rc.UserID = b.GetUserId() // ledger ที่มาจาก webhook อาจไม่รู้ user
+	}
+	if s.seller.TaxID != "" {
+		rc.Kind = domain.ReceiptTaxInvoice
+		rc.SellerTaxID = s.seller.TaxID
+		rc.VATPercent = s.seller.VATPercent
+		rc.VATAmount = int64(math.Round(float64(p.Amount) * s.seller.VATPercent / (100 + s.seller.VATPercent)))
+	}
+
+	prefix := "RC"
+	if rc.Kind == domain.ReceiptTaxInvoice {
+		prefix = "TX"
+	}
+	series := fmt.Sprintf("%s%d", prefix, rc.PaidAt.In(s.loc).Year()) // ปีที่รับเงิน ไม่ใช่ปีที่ออกใบ (จ่ายปลายปีแล้วขอใบต้นปี)
+	if _, err := s.repo.CreateReceipt(ctx, rc, series, s.render); err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
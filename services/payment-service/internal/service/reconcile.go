@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/omise/omise-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/pkg/outbox"
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// reconcileOverlap รอบถัดไปถอยจากขอบบนของรอบก่อนเท่านี้ (charge ที่ webhook มาช้าช่วงรอยต่อ)
+const reconcileOverlap = time.Hour
+
+// Reconcile เทียบ charge ที่ gateway สร้างในช่วง [from, to) กับ ledger และสถานะ booking
+// สิ่งที่แก้เองได้ (ledger ไม่ตรง, payment.paid/failed ที่ webhook หาย) จะถูกแก้ทันที
+// ทุกความคลาดเคลื่อนถูกบันทึกเป็นรายงานของรอบนั้น (GetReconciliationReport)
+func (s *PaymentSvc) Reconcile(ctx context.Context, from, to time.Time) (*domain.ReconciliationRun, error) {
+	return s.reconcile(ctx, from, to, from)
+}
+
+// ReconcileSince รอบตามเวลาของ reconciler: list จาก gateway เฉพาะตั้งแต่รอบที่สำเร็จล่าสุด (ถอย reconcileOverlap)
+// แทนการไล่ lookback ทั้งช่วงทุกรอบ ส่วนแถว ledger ที่ยัง pending ก่อนหน้านั้น (ภายใน lookback) ดึงจาก gateway ทีละตัว
+// เพราะสถานะยังเปลี่ยนได้
+func (s *PaymentSvc) ReconcileSince(ctx context.Context, lookback time.Duration, to time.Time) (*domain.ReconciliationRun, error) {
+	floor := to.Add(-lookback)
+	from := floor
+	last, err := s.repo.LastRun(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if last != nil {
+		mark := last.To
+		if last.StartedAt.Before(mark) { // รอบที่ admin สั่งด้วย to ในอนาคต ตรวจได้จริงแค่ถึงตอนเริ่ม
+			mark = last.StartedAt
+		}
+		if mark = mark.Add(-reconcileOverlap); mark.After(from) {
+			from = mark
+		}
+	}
+	if from.After(to) {
+		from = to
+	}
+	return s.reconcile(ctx, from, to, floor)
+}
+
+// reconcile ตรวจ charge ในช่วง [from, to) และแถว ledger ที่ยัง pending ในช่วง [recheckFrom, from)
+func (s *PaymentSvc) reconcile(ctx context.Context, from, to, recheckFrom time.Time) (*domain.ReconciliationRun, error) {
+	run := &domain.ReconciliationRun{ID: uuid.NewString(), From: from, To: to, StartedAt: time.Now().UTC()}
+
+	charges, err := s.prov.ListCharges(ctx, from, to)
+	if err != nil {
+		run.Error = err.Error()
+		run.FinishedAt = time.Now().UTC()
+		if serr := s.repo.SaveRun(ctx, run); serr != nil {
+			log.Printf("[reconcile] save run %s: %v", run.ID, serr)
+		}
+		return run, err
+	}
+	if recheckFrom.Before(from) {
+		pending, err := s.repo.PendingBetween(ctx, recheckFrom, from)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pending {
+			ch, err := s.prov.RetrieveCharge(ctx, p.ChargeID)
+			if err != nil {
+				log.Printf("[reconcile] retrieve %s: %v", p.ChargeID, err)
+				continue
+			}
+			charges = append(charges, ch)
+		}
+	}
+
+	ids := make([]string, 0, len(charges))
+	for _, ch := range charges {
+		ids = append(ids, ch.ID)
+	}
+	ledger, err := s.repo.ByCharges(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings := map[string]*bookingv1.GetBookingResponse{}
+	seen := make(map[string]bool, len(charges))
+	for _, ch := range charges {
+		seen[ch.ID] = true
+		bookingID, _ := ch.Metadata["booking_id"].(string)
+		if bookingID == "" {
+			if WalletUserOf(ch) != "" {
+				run.ChargesChecked++
+				run.Items = append(run.Items, s.reconcileTopUp(ctx, run.ID, ch)...)
+			}
+			continue // ไม่ใช่ charge ที่ระบบนี้สร้าง
+		}
+		run.ChargesChecked++
+		run.Items = append(run.Items, s.reconcileCharge(ctx, run.ID, ch, bookingID, ledger, bookings)...)
+	}
+
+	// แถวใน ledger ที่ gateway ไม่ส่งมา: ยืนยันทีละตัวก่อน (created_at ของ ledger ช้ากว่า gateway เล็กน้อยจึงอาจหลุดขอบช่วง)
+	rows, err := s.repo.CreatedBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range rows {
+		if seen[p.ChargeID] || p.Method == domain.MethodWallet { // จ่ายด้วย wallet ไม่มีที่ gateway
+			continue
+		}
+		if _, err := s.prov.RetrieveCharge(ctx, p.ChargeID); err == nil {
+			continue
+		}
+		run.Items = append(run.Items, domain.ReconciliationItem{
+			RunID: run.ID, ChargeID: p.ChargeID, BookingID: p.BookingID,
+			Kind:   domain.DiscMissingAtProvider,
+			Detail: fmt.Sprintf("ledger status=%s amount=%d", p.Status, p.Amount),
+		})
+	}
+
+	run.FinishedAt = time.Now().UTC()
+	if err := s.repo.SaveRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (s *PaymentSvc) reconcileCharge(ctx context.Context, runID string, ch *omise.Charge, bookingID string, ledger map[string]domain.Payment, bookings map[string]*bookingv1.GetBookingResponse) []domain.ReconciliationItem {
+	var items []domain.ReconciliationItem
+	add := func(kind, detail, action string) {
+		items = append(items, domain.ReconciliationItem{
+			RunID: runID, ChargeID: ch.ID, BookingID: bookingID, Kind: kind, Detail: detail, Action: action,
+		})
+	}
+
+	res, ok := bookings[bookingID]
+	if !ok {
+		var err error
+		res, err = s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+		if err != nil {
+			log.Printf("[reconcile] get booking %s: %v", bookingID, err)
+		}
+		bookings[bookingID] = res
+	}
+	b := res.GetBooking() // nil ถ้าหาไม่ได้
+
+	// 1) ledger
+	chStatus := string(ch.Status)
+	p, inLedger := ledger[ch.ID]
+	switch {
+	case !inLedger:
+		add(domain.DiscMissingInLedger, fmt.Sprintf("status=%s amount=%d", chStatus, ch.Amount), s.recordAction(ctx, b.GetUserId(), ch))
+	case p.Status != chStatus:
+		add(domain.DiscStatusMismatch, fmt.Sprintf("ledger=%s provider=%s", p.Status, chStatus), s.recordAction(ctx, "", ch))
+	case p.Amount != ch.Amount || p.RefundedAmount != ch.RefundedAmount:
+		add(domain.DiscAmountMismatch, fmt.Sprintf("ledger amount=%d refunded=%d, provider amount=%d refunded=%d",
+			p.Amount, p.RefundedAmount, ch.Amount, ch.RefundedAmount), s.recordAction(ctx, "", ch))
+	}
+
+	// 2) booking (ดูเฉพาะ charge ที่จบแล้ว)
+	if b == nil {
+		return items
+	}
+	unrefunded := ch.RefundedAmount < ch.Amount
+	if shareID := ShareOf(ch); shareID != "" {
+		// ส่วนหารจ่ายที่ล้มเหลว booking ไม่สนใจ (คนนั้นจ่ายใหม่ได้) จึงดูเฉพาะที่สำเร็จ
+		if ch.Status != omise.ChargeSuccessful {
+			return items
+		}
+		sh := findShare(res, shareID)
+		switch {
+		case sh.GetStatus() == sharePaid && sh.GetPaymentId() == ch.ID:
+			// ตรงกันแล้ว (ถ้า booking ถูกยกเลิก การคืนเงินไปตาม booking.cancelled)
+		case sh.GetStatus() == sharePending && b.Status == bookingv1.BookingStatus_PENDING && holdExpired(b, time.Now()):
+			add(domain.DiscPaidInactive, fmt.Sprintf("charge successful but share %s is PENDING and the hold expired at %s", shareID, b.ExpiresAtIso),
+				s.refundAction(ctx, ch, "hold expired"))
+		case sh.GetStatus() == sharePending && b.Status == bookingv1.BookingStatus_PENDING:
+			add(domain.DiscBookingNotPaid, fmt.Sprintf("charge successful but share %s is PENDING", shareID),
+				s.publishAction(ctx, paidEvent(ctx, ch)))
+		// เงินที่ไม่ถูกนับเป็นส่วนใด (booking.payment_rejected หาย): คืนด้วย key เดียวกับ consumer
+		case sh.GetStatus() == sharePaid && unrefunded:
+			add(domain.DiscDuplicatePayment, fmt.Sprintf("share %s was paid by %s", shareID, sh.GetPaymentId()),
+				s.refundAction(ctx, ch, "share already paid"))
+		case unrefunded:
+			add(domain.DiscPaidInactive, fmt.Sprintf("share %s is %q, booking is %s; refundable %d",
+				shareID, sh.GetStatus(), b.Status, ch.Amount-ch.RefundedAmount),
+				s.refundAction(ctx, ch, fmt.Sprintf("share is %q, booking is %s", sh.GetStatus(), b.Status)))
+		}
+		return items
+	}
+	switch ch.Status {
+	case omise.ChargeSuccessful:
+		switch b.Status {
+		case bookingv1.BookingStatus_PENDING:
+			if holdExpired(b, time.Now()) {
+				// hold หมดแล้ว (sweeper ยังไม่ทันปิด): ยืนยันไม่ได้ คืนเงินแทนการส่ง payment.paid ซ้ำ
+				add(domain.DiscPaidInactive, "charge successful but the PENDING hold expired at "+b.ExpiresAtIso,
+					s.refundAction(ctx, ch, "hold expired"))
+				break
+			}
+			add(domain.DiscBookingNotPaid, "charge successful but booking is PENDING",
+				s.publishAction(ctx, paidEvent(ctx, ch)))
+		case bookingv1.BookingStatus_CONFIRMED, bookingv1.BookingStatus_COMPLETED, bookingv1.BookingStatus_NO_SHOW:
+			if b.PaymentId != "" && b.PaymentId != ch.ID && unrefunded {
+				add(domain.DiscDuplicatePayment, fmt.Sprintf("booking %s was paid by %s", b.Status, b.PaymentId), "")
+			}
+		case bookingv1.BookingStatus_CANCELLED, bookingv1.BookingStatus_EXPIRED:
+			if b.PaymentId != ch.ID && unrefunded {
+				add(domain.DiscPaidInactive, fmt.Sprintf("booking is %s; refundable %d", b.Status, ch.Amount-ch.RefundedAmount), "")
+			}
+		}
+	case omise.ChargeFailed, "expired": // SDK ไม่มีค่าคงที่ของ expired
+		// ledger รู้สถานะนี้แล้ว = webhook ทำงานแล้ว ไม่ต้องส่งซ้ำ
+		if b.Status == bookingv1.BookingStatus_PENDING && (!inLedger || p.Status != chStatus) {
+			fc, _ := failureOf(ch)
+			add(domain.DiscFailureNotReported, fmt.Sprintf("charge %s (%s) but booking is PENDING", chStatus, fc),
+				s.publishAction(ctx, failedEvent(ctx, ch)))
+		}
+	}
+	return items
+}
+
+// reconcileTopUp charge เติม wallet ที่สำเร็จแต่ยอดยังไม่เข้า wallet (webhook หาย)
+func (s *PaymentSvc) reconcileTopUp(ctx context.Context, runID string, ch *omise.Charge) []domain.ReconciliationItem {
+	if ch.Status != omise.ChargeSuccessful {
+		return nil
+	}
+	credited, err := s.CreditTopUp(ctx, ch)
+	if !credited && err == nil {
+		return nil // เติมไปแล้ว
+	}
+	action := "credited"
+	if err != nil {
+		action = "credit failed: " + err.Error()
+	}
+	s.record(ctx, WalletUserOf(ch), ch)
+	return []domain.ReconciliationItem{{
+		RunID: runID, ChargeID: ch.ID, Kind: domain.DiscTopUpNotCredited,
+		Detail: fmt.Sprintf("top-up %d for user %s", ch.Amount, WalletUserOf(ch)), Action: action,
+	}}
+}
+
+func (s *PaymentSvc) recordAction(ctx context.Context, userID string, ch *omise.Charge) string {
+	if err := s.RecordCharge(ctx, userID, ch); err != nil {
+		return "record failed: " + err.Error()
+	}
+	return "recorded"
+}
+
+// refundAction คืนเงินที่เหลือของ charge ด้วย key เดียวกับ booking.payment_rejected (ไม่คืนซ้ำกับ consumer)
+func (s *PaymentSvc) refundAction(ctx context.Context, ch *omise.Charge, why string) string {
+	rf, err := s.Refund(ctx, RefundInput{
+		ChargeID: ch.ID,
+		Reason:   "payment not applied to booking: " + why,
+		Key:      RejectedRefundKey(ch.ID),
+	})
+	if err != nil {
+		return "refund failed: " + err.Error()
+	}
+	return fmt.Sprintf("refunded %d (%s)", rf.Amount, rf.ID)
+}
+
+// holdExpired booking PENDING ที่ hold หมดเวลาแล้ว
+func holdExpired(b *bookingv1.Booking, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, b.GetExpiresAtIso())
+	return err == nil && !now.Before(exp)
+}
+
+// publishAction ส่ง event ซ้ำผ่าน outbox (relay เป็นคน publish)
+func (s *PaymentSvc) publishAction(ctx context.Context, ev outbox.Event) string {
+	if err := s.repo.Enqueue(ctx, ev); err != nil {
+		return "publish " + ev.Key + " failed: " + err.Error()
+	}
+	return "published " + ev.Key
+}
+
+// ReconciliationReport รายงานของรอบ runID (ว่าง = รอบล่าสุด)
+func (s *PaymentSvc) ReconciliationReport(ctx context.Context, runID string) (*domain.ReconciliationRun, error) {
+	run, err := s.repo.Run(ctx, runID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "reconciliation run not found")
+	}
+	return run, err
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+)
+
+func TestHoldExpired(t *testing.T) {
+	now := time.Date(2025, 1, 7, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		exp  string
+		want bool
+	}{
+		{"before expiry", now.Add(time.Minute).Format(time.RFC3339), false},
+		{"at expiry", now.Format(time.RFC3339), true},
+		{"after expiry", now.Add(-time.Minute).Format(time.RFC3339), true},
+		{"no expiry", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bookingv1.Booking{Status: bookingv1.BookingStatus_PENDING, ExpiresAtIso: tt.exp}
+			if got := holdExpired(b, now); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omise/omise-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/provider"
+)
+
+// refund ที่ fake คืนด้วย metadata refund_key ต้องหาเจอจาก charge ตอนรับช่วงแถว pending
+func TestRefundWithKey(t *testing.T) {
+	ctx := context.Background()
+	fake := provider.NewFake("", "", 0)
+	ch, err := fake.CreateCharge(ctx, provider.ChargeRequest{Amount: 20000, Currency: "thb", Card: "tokn_ok", Metadata: chargeMeta("b1", "")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := fake.Refund(ctx, ch.ID, 5000, map[string]any{metaRefundKey: "k1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest, err := fake.RetrieveCharge(ctx, ch.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := refundWithKey(latest, "k1"); got == nil || got.ID != res.ID {
+		t.Fatalf("refundWithKey(k1) = %+v, want %s", got, res.ID)
+	}
+	if got := refundWithKey(latest, "k2"); got != nil {
+		t.Fatalf("refundWithKey(k2) = %+v, want nil", got)
+	}
+	if got := refundWithKey(ch, "k1"); got != nil {
+		t.Fatal("charge returned before the refund must not see it")
+	}
+}
+
+func TestRefundable(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   omise.ChargeStatus
+		refunded int64
+		amount   int64
+		want     codes.Code
+	}{
+		{"full", omise.ChargeSuccessful, 0, 0, codes.OK},
+		{"partial", omise.ChargeSuccessful, 5000, 15000, codes.OK},
+		{"more than remaining", omise.ChargeSuccessful, 5000, 15001, codes.FailedPrecondition},
+		{"fully refunded", omise.ChargeSuccessful, 20000, 0, codes.FailedPrecondition},
+		{"not successful", omise.ChargePending, 0, 0, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := testCharge(tt.status, nil)
+			ch.RefundedAmount = tt.refunded
+			if got := status.Code(refundable(ch, tt.amount)); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
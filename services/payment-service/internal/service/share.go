@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/omise/omise-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+)
+
+// metadata ของ charge ที่จ่ายส่วนหนึ่งของ booking หารจ่าย
+const metaShare = "share_id"
+
+// สถานะของส่วนแบ่งตามที่ booking-service ส่งมา
+const (
+	sharePending   = "PENDING"
+	sharePaid      = "PAID"
+	shareCancelled = "CANCELLED"
+)
+
+// ShareOf share id ของ charge ("" = จ่ายทั้ง booking)
+func ShareOf(ch *omise.Charge) string {
+	id, _ := ch.Metadata[metaShare].(string)
+	return id
+}
+
+// chargeMeta metadata ของ charge ที่จ่าย booking (หรือส่วน shareID)
+func chargeMeta(bookingID, shareID string) map[string]any {
+	m := map[string]any{"booking_id": bookingID}
+	if shareID != "" {
+		m[metaShare] = shareID
+	}
+	return m
+}
+
+// splitting booking ถูกหารจ่ายอยู่ (มีส่วนที่ยังไม่ถูกยกเลิก)
+func splitting(res *bookingv1.GetBookingResponse) bool {
+	for _, sh := range res.GetShares() {
+		if sh.GetStatus() != shareCancelled {
+			return true
+		}
+	}
+	return false
+}
+
+func findShare(res *bookingv1.GetBookingResponse, shareID string) *bookingv1.PaymentShare {
+	for _, sh := range res.GetShares() {
+		if sh.GetId() == shareID {
+			return sh
+		}
+	}
+	return nil
+}
+
+// checkShare ยอดของ charge ต้องตรงกับส่วน shareID ที่ยังรอจ่ายอยู่
+func checkShare(res *bookingv1.GetBookingResponse, shareID string, amount int64, currency string) error {
+	if shareID == "" {
+		return status.Errorf(codes.FailedPrecondition, "booking %s is split; pay a share by share_id", res.GetBooking().GetId())
+	}
+	sh := findShare(res, shareID)
+	switch {
+	case sh == nil:
+		return status.Errorf(codes.NotFound, "share %s not found in booking %s", shareID, res.GetBooking().GetId())
+	case sh.GetStatus() != sharePending:
+		return status.Errorf(codes.FailedPrecondition, "share %s is %s", shareID, sh.GetStatus())
+	case res.GetBooking().GetStatus() != bookingv1.BookingStatus_PENDING:
+		return status.Errorf(codes.FailedPrecondition, "booking is %s", res.GetBooking().GetStatus())
+	case amount != sh.GetAmount() || !strings.EqualFold(currency, res.GetBooking().GetCurrency()):
+		return status.Errorf(codes.InvalidArgument, "amount mismatch: share %s costs %d %s", shareID, sh.GetAmount(), res.GetBooking().GetCurrency())
+	}
+	return nil
+}
+
+// payerOf user id ผู้จ่ายสำหรับ ledger: เจ้าของ booking หรือเจ้าของส่วน (ว่างถ้าเชิญด้วย email / หาไม่ได้)
+func (s *PaymentSvc) payerOf(ctx context.Context, bookingID, shareID string) string {
+	if shareID == "" {
+		return s.ownerOf(ctx, bookingID)
+	}
+	res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+	if err != nil {
+		return ""
+	}
+	return findShare(res, shareID).GetUserId()
+}
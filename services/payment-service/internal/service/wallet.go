@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/omise/omise-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/pkg/events"
+	bookingv1 "github.com/you/badminton-booking/proto/booking/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+	"github.com/you/badminton-booking/services/payment-service/internal/provider"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
+)
+
+// metaWalletUser metadata ของ charge เติม wallet (charge เหล่านี้ไม่มี booking_id)
+const metaWalletUser = "wallet_user_id"
+
+// WalletUserOf user id ของ charge เติม wallet ("" = ไม่ใช่ charge เติมเงิน)
+func WalletUserOf(ch *omise.Charge) string {
+	id, _ := ch.Metadata[metaWalletUser].(string)
+	return id
+}
+
+// walletStatus แปลง error ของ repository เป็น gRPC status
+func walletStatus(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrInsufficientFunds), errors.Is(err, repository.ErrWalletCurrency):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+func (s *PaymentSvc) GetWallet(ctx context.Context, userID string) (*domain.Wallet, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return s.repo.Wallet(ctx, userID)
+}
+
+func (s *PaymentSvc) WalletTransactions(ctx context.Context, userID string, page, size int32) ([]domain.WalletTransaction, int64, error) {
+	if userID == "" {
+		return nil, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return s.repo.WalletTransactions(ctx, userID, page, size)
+}
+
+// ---------- Top-up ----------
+type TopUpInput struct {
+	UserID         string
+	Amount         int64
+	Currency       string
+	CardToken      string // อย่างใดอย่างหนึ่งกับ SourceType
+	SourceType     string // เช่น promptpay
+	ReturnURI      string
+	IdempotencyKey string // ว่าง = ไม่กันซ้ำ
+}
+
+// TopUp สร้าง Omise charge เติมเงิน; สำเร็จทันที (บัตร) = เข้า wallet เลย
+// pending (3DS/QR) = เข้า wallet ตอน webhook charge.complete (CreditTopUp)
+func (s *PaymentSvc) TopUp(ctx context.Context, in TopUpInput) (*omise.Charge, error) {
+	if in.UserID == "" || in.Amount <= 0 || in.Currency == "" || (in.CardToken == "") == (in.SourceType == "") {
+		return nil, status.Error(codes.InvalidArgument, "user_id, amount, currency and either card_token or source_type are required")
+	}
+	w, err := s.repo.Wallet(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if w.Currency != "" && !strings.EqualFold(w.Currency, in.Currency) {
+		return nil, status.Errorf(codes.InvalidArgument, "wallet currency is %s", w.Currency)
+	}
+	return s.idempotent(ctx, in.UserID, in.IdempotencyKey, "wallet:"+in.UserID, func() (*omise.Charge, error) {
+		req := provider.ChargeRequest{
+			Amount:    in.Amount,
+			Currency:  in.Currency,
+			Card:      in.CardToken,
+			ReturnURI: in.ReturnURI,
+			Metadata:  map[string]any{metaWalletUser: in.UserID},
+		}
+		if in.SourceType != "" {
+			src, err := s.CreateSourceOrUseExisting(ctx, in.Amount, in.Currency, "", in.SourceType, in.ReturnURI)
+			if err != nil {
+				return nil, err
+			}
+			req.Source = src.ID
+		}
+		ch, err := s.prov.CreateCharge(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		s.record(ctx, in.UserID, ch)
+		if ch.Status == omise.ChargeSuccessful {
+			if _, err := s.CreditTopUp(context.WithoutCancel(ctx), ch); err != nil {
+				// charge สำเร็จแล้ว: webhook/reconcile จะเติมให้อีกรอบ
+				log.Printf("[wallet] credit top-up %s: %v", ch.ID, err)
+			}
+		}
+		return ch, nil
+	})
+}
+
+// CreditTopUp เติมยอดของ charge เติมเงินที่สำเร็จแล้วเข้า wallet (ครั้งเดียวต่อ charge)
+// credited = false คือเคยเติมไปแล้ว
+func (s *PaymentSvc) CreditTopUp(ctx context.Context, ch *omise.Charge) (credited bool, err error) {
+	userID := WalletUserOf(ch)
+	if userID == "" || ch.Status != omise.ChargeSuccessful {
+		return false, fmt.Errorf("charge %s is not a successful wallet top-up", ch.ID)
+	}
+	return s.repo.WalletTopUp(ctx, &domain.WalletTransaction{
+		ID:       "wtx_" + uuid.NewString(),
+		UserID:   userID,
+		Kind:     domain.WalletTopUp,
+		Amount:   ch.Amount,
+		Currency: ch.Currency,
+		ChargeID: ch.ID,
+		Key:      "topup:" + ch.ID,
+	})
+}
+
+// ---------- Pay ----------
+
+// PayWithWallet ตัดเงินค่า booking จาก wallet พร้อม payment.paid (outbox) แบบเดียวกับ charge ปกติ
+// เรียกซ้ำกับ booking เดิมไม่ตัดเงินซ้ำ (คืนรายการเดิม)
+func (s *PaymentSvc) PayWithWallet(ctx context.Context, userID, bookingID string) (*domain.WalletTransaction, error) {
+	if userID == "" || bookingID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and booking_id are required")
+	}
+	res, err := s.booking.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID})
+	if err != nil {
+		return nil, err
+	}
+	b := res.GetBooking()
+	if b.GetUserId() != userID {
+		return nil, status.Error(codes.PermissionDenied, "booking belongs to another user")
+	}
+	if splitting(res) {
+		return nil, status.Error(codes.FailedPrecondition, "booking is split; pay each share with a charge")
+	}
+
+	currency := strings.ToLower(b.GetCurrency()) // ให้เหมือน charge ของ Omise
+	wt := &domain.WalletTransaction{
+		ID:        "wtx_" + uuid.NewString(),
+		UserID:    userID,
+		Kind:      domain.WalletPayment,
+		Amount:    -b.GetAmount(),
+		Currency:  currency,
+		BookingID: bookingID,
+		Key:       "pay:" + bookingID,
+	}
+	wt.ChargeID = wt.ID
+	now := time.Now().UTC()
+	p := &domain.Payment{
+		ChargeID:  wt.ID,
+		BookingID: bookingID,
+		UserID:    userID,
+		Amount:    b.GetAmount(),
+		Currency:  currency,
+		Method:    domain.MethodWallet,
+		Status:    string(omise.ChargeSuccessful),
+		Raw:       "{}",
+		PaidAt:    &now,
+	}
+
+	unlock, err := s.lockPayment(ctx, bookingID, "")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// จ่ายด้วย wallet ไปแล้ว (เรียกซ้ำ) = คืนรายการเดิม; payment.paid ถูกเขียนลง outbox ไปพร้อมการตัดเงินแล้ว
+	prev, err := s.repo.SuccessfulByBooking(ctx, bookingID)
+	switch {
+	case err == nil && prev.Method == domain.MethodWallet:
+		return s.repo.WalletTransaction(ctx, prev.ChargeID)
+	case err == nil:
+		return nil, status.Errorf(codes.AlreadyExists, "booking %s is already paid (charge %s)", bookingID, prev.ChargeID)
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	case b.GetStatus() != bookingv1.BookingStatus_PENDING:
+		return nil, status.Errorf(codes.FailedPrecondition, "booking is %s", b.GetStatus())
+	case holdExpired(b, time.Now()):
+		// sweeper ยังไม่ทันเปลี่ยนเป็น EXPIRED: booking จะไม่รับ payment.paid นี้
+		return nil, status.Errorf(codes.FailedPrecondition, "booking hold expired at %s", b.GetExpiresAtIso())
+	case b.GetAmount() <= 0:
+		return nil, status.Error(codes.FailedPrecondition, "booking has nothing to pay")
+	}
+
+	paid := envelope(ctx, events.RKPaymentPaid, events.PaymentPaid{
+		PaymentID: wt.ChargeID,
+		BookingID: bookingID,
+		Amount:    -wt.Amount,
+		Currency:  wt.Currency,
+		Method:    domain.MethodWallet,
+	})
+	created, err := s.repo.WalletPay(ctx, wt, p, paid)
+	if err != nil {
+		return nil, walletStatus(err)
+	}
+	if !created {
+		// คำขอพร้อมกันอีกตัวตัดเงินไปก่อน (key เดียวกัน)
+		if prev, err := s.repo.SuccessfulByBooking(ctx, bookingID); err == nil {
+			return s.repo.WalletTransaction(ctx, prev.ChargeID)
+		}
+		return nil, status.Error(codes.Aborted, "wallet payment for this booking is in progress")
+	}
+	s.hub.publish(*p)
+	return wt, nil
+}
+
+// ---------- Refund ----------
+
+// refundToWallet คืนเงินของ booking ที่จ่ายด้วย wallet กลับเข้า wallet (แทนการคืนผ่าน Omise)
+func (s *PaymentSvc) refundToWallet(ctx context.Context, p *domain.Payment, in RefundInput) (*domain.Refund, error) {
+	remaining := p.Amount - p.RefundedAmount
+	amount := in.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 || amount > remaining {
+		return nil, status.Errorf(codes.FailedPrecondition, "refund amount %d exceeds refundable %d", amount, remaining)
+	}
+	rf := &domain.Refund{
+		ID:        "wrf_" + uuid.NewString(),
+		ChargeID:  p.ChargeID,
+		BookingID: p.BookingID,
+		Amount:    amount,
+		Currency:  p.Currency,
+		Reason:    in.Reason,
+		Key:       in.Key,
+	}
+	if rf.Key == "" {
+		rf.Key = rf.ID
+	}
+	created, err := s.repo.WalletRefund(ctx, &domain.WalletTransaction{
+		ID:        "wtx_" + uuid.NewString(),
+		UserID:    p.UserID,
+		Kind:      domain.WalletRefund,
+		Amount:    amount,
+		Currency:  p.Currency,
+		ChargeID:  p.ChargeID,
+		BookingID: p.BookingID,
+		Key:       "refund:" + rf.Key,
+	}, rf, refundedEvent(ctx, rf))
+	if err != nil {
+		return nil, walletStatus(err)
+	}
+	if !created {
+		return s.repo.RefundByKey(ctx, rf.Key)
+	}
+	return rf, nil
+}
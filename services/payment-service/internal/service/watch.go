@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+)
+
+// watchPoll อ่าน ledger ซ้ำเป็นระยะ เผื่อ webhook ไปลงที่ instance อื่น (hub แจ้งได้เฉพาะใน process เดียวกัน)
+const watchPoll = 5 * time.Second
+
+// chargeHub แจ้งผู้ที่ Watch charge อยู่ทุกครั้งที่ ledger ของ charge นั้นถูกเขียน
+type chargeHub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan domain.Payment]struct{}
+}
+
+func (h *chargeHub) subscribe(chargeID string) (chan domain.Payment, func()) {
+	ch := make(chan domain.Payment, 1)
+	h.mu.Lock()
+	if h.subs == nil {
+		h.subs = map[string]map[chan domain.Payment]struct{}{}
+	}
+	if h.subs[chargeID] == nil {
+		h.subs[chargeID] = map[chan domain.Payment]struct{}{}
+	}
+	h.subs[chargeID][ch] = struct{}{}
+	h.mu.Unlock()
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs[chargeID], ch)
+		if len(h.subs[chargeID]) == 0 {
+			delete(h.subs, chargeID)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// publish ไม่ block: ผู้ฟังที่ยังไม่อ่านค่าเก่าจะได้ค่าล่าสุดแทน
+func (h *chargeHub) publish(p domain.Payment) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[p.ChargeID] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- p
+	}
+}
+
+// FinalChargeStatus charge ที่จบแล้ว ไม่มีการเปลี่ยนสถานะอีก (ยกเว้นคืนเงิน)
+func FinalChargeStatus(st string) bool {
+	switch st {
+	case "successful", "failed", "expired", "reversed":
+		return true
+	}
+	return false
+}
+
+// RoleAdmin role ที่ดู payment ของทุกคนได้
+const RoleAdmin = "ADMIN"
+
+// ownsPayment ผู้เรียกเป็นผู้จ่ายใน ledger (หรือเจ้าของ booking/ส่วน ถ้า ledger ไม่รู้ผู้จ่าย); ADMIN และ service ภายใน (callerID ว่าง) ผ่านเสมอ
+func (s *PaymentSvc) ownsPayment(ctx context.Context, p *domain.Payment, callerID, role string) bool {
+	if callerID == "" || role == RoleAdmin {
+		return true
+	}
+	payer := p.UserID
+	if payer == "" && p.BookingID != "" {
+		payer = s.payerOf(ctx, p.BookingID, p.ShareID)
+	}
+	return payer != "" && payer == callerID
+}
+
+// Watch ส่งสถานะปัจจุบันของ charge แล้วส่งทุกครั้งที่สถานะเปลี่ยน จนกว่าจะจบ (FinalChargeStatus) หรือ ctx ถูก cancel
+// ดูได้เฉพาะผู้จ่ายของ charge หรือ ADMIN (คนอื่นได้ NotFound เหมือนไม่มี charge)
+func (s *PaymentSvc) Watch(ctx context.Context, chargeID, callerID, role string, send func(domain.Payment) error) error {
+	if chargeID == "" {
+		return status.Error(codes.InvalidArgument, "charge_id is required")
+	}
+	updates, unsubscribe := s.hub.subscribe(chargeID)
+	defer unsubscribe()
+
+	cur, err := s.repo.ByCharge(ctx, chargeID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !s.ownsPayment(ctx, cur, callerID, role)) {
+		return status.Errorf(codes.NotFound, "charge %s not found", chargeID)
+	}
+	if err != nil {
+		return err
+	}
+	if err := send(*cur); err != nil {
+		return err
+	}
+	last := cur.Status
+
+	t := time.NewTicker(watchPoll)
+	defer t.Stop()
+	for !FinalChargeStatus(last) {
+		var p domain.Payment
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case p = <-updates:
+		case <-t.C:
+			latest, err := s.repo.ByCharge(ctx, chargeID)
+			if err != nil {
+				return err
+			}
+			p = *latest
+		}
+		if p.Status == last {
+			continue
+		}
+		if p.BookingID == "" {
+			p.BookingID = cur.BookingID // webhook อาจไม่รู้ booking; Upsert เก็บค่าเดิมไว้
+		}
+		if err := send(p); err != nil {
+			return err
+		}
+		last = p.Status
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package settlement
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/you/badminton-booking/services/payment-service/internal/service"
+)
+
+// รอบของใบสรุป (PAYMENT_PAYOUT_PERIOD)
+const (
+	Weekly  = "weekly"  // จันทร์ 00:00 ถึงจันทร์ถัดไป
+	Monthly = "monthly" // วันที่ 1 00:00 ถึงวันที่ 1 เดือนถัดไป
+)
+
+// Settler ออกใบสรุปของงวดที่เพิ่งปิด (ตามเวลาท้องถิ่น loc) ทุก interval; ใบที่ออกแล้วไม่ถูกออกซ้ำ
+type Settler struct {
+	svc      *service.PayoutSvc
+	period   string
+	loc      *time.Location
+	interval time.Duration
+}
+
+func New(svc *service.PayoutSvc, period string, loc *time.Location, interval time.Duration) *Settler {
+	if period != Weekly {
+		period = Monthly
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Settler{svc: svc, period: period, loc: loc, interval: interval}
+}
+
+// Run วนทุก interval จนกว่า ctx จะถูก cancel (เรียกใน goroutine)
+func (w *Settler) Run(ctx context.Context) {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		w.settle(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *Settler) settle(ctx context.Context) {
+	to := w.periodStart(time.Now().In(w.loc))
+	from := w.periodStart(to.Add(-time.Nanosecond))
+	payouts, skipped, err := w.svc.Settle(ctx, from, to)
+	if err != nil {
+		log.Printf("[payout] settle %s..%s error: %v", from.Format(time.DateOnly), to.Format(time.DateOnly), err)
+	}
+	if len(payouts) > 0 || len(skipped) > 0 {
+		log.Printf("[payout] %s..%s: %d statement(s), %d line(s) skipped (statement already issued)",
+			from.Format(time.DateOnly), to.Format(time.DateOnly), len(payouts), len(skipped))
+	}
+}
+
+// periodStart จุดเริ่มงวดที่ t อยู่
+func (w *Settler) periodStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	if w.period == Monthly {
+		return time.Date(y, m, 1, 0, 0, 0, 0, w.loc)
+	}
+	back := (int(t.Weekday()) + 6) % 7 // จันทร์ = 0
+	return time.Date(y, m, d-back, 0, 0, 0, 0, w.loc)
+}
@@ -2,25 +2,38 @@ package grpc
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/metadata"
 
 	paymentv1 "github.com/you/badminton-booking/proto/payment/v1"
+	"github.com/you/badminton-booking/services/payment-service/internal/domain"
+	"github.com/you/badminton-booking/services/payment-service/internal/repository"
 	"github.com/you/badminton-booking/services/payment-service/internal/service"
 )
 
 type Server struct {
 	paymentv1.UnimplementedPaymentServiceServer
-	svc *service.PaymentSvc
+	svc      *service.PaymentSvc
+	payouts  *service.PayoutSvc
+	receipts *service.ReceiptSvc
 }
 
-func NewServer(s *service.PaymentSvc) *Server { return &Server{svc: s} }
+func NewServer(s *service.PaymentSvc, payouts *service.PayoutSvc, receipts *service.ReceiptSvc) *Server {
+	return &Server{svc: s, payouts: payouts, receipts: receipts}
+}
 
 // ---------- Card ----------
 func (s *Server) CreateCardCharge(ctx context.Context, in *paymentv1.CreateCardChargeRequest) (*paymentv1.CreateCardChargeResponse, error) {
-	ch, err := s.svc.CreateCardCharge(service.CreateCardChargeInput{
+	ch, err := s.svc.CreateCardCharge(ctx, service.CreateCardChargeInput{
 		BookingID: in.BookingId,
+		ShareID:   in.ShareId,
 		Amount:    in.Amount,
 		Currency:  in.Currency,
 		CardToken: in.CardToken,
+
+		IdempotencyKey: in.IdempotencyKey,
+		CallerID:       callerID(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -35,6 +48,11 @@ func (s *Server) CreateCardCharge(ctx context.Context, in *paymentv1.CreateCardC
 
 // ---------- Source (client ส่ง source_id + return_uri มา; return_uri ไม่ได้ใช้ตอน charge) ----------
 func (s *Server) CreateSourceCharge(ctx context.Context, in *paymentv1.CreateSourceChargeRequest) (*paymentv1.CreateSourceChargeResponse, error) {
+	// 0) ยอดต้องตรงกับราคาของ booking ก่อนสร้าง source
+	if err := s.svc.CheckAmount(ctx, in.BookingId, in.ShareId, in.Amount, in.Currency); err != nil {
+		return nil, err
+	}
+
 	// 1) ได้ source (จาก id หรือสร้างใหม่จาก type)
 	src, err := s.svc.CreateSourceOrUseExisting(ctx, in.Amount, in.Currency, in.SourceId, in.SourceType, in.ReturnUri)
 	if err != nil {
@@ -42,26 +60,38 @@ func (s *Server) CreateSourceCharge(ctx context.Context, in *paymentv1.CreateSou
 	}
 
 	// 2) Charge ด้วย src.ID
-	ch, err := s.svc.CreateChargeWithSourceID(service.CreateChargeWithSourceIDInput{
+	ch, err := s.svc.CreateChargeWithSourceID(ctx, service.CreateChargeWithSourceIDInput{
 		BookingID: in.BookingId,
+		ShareID:   in.ShareId,
 		Amount:    in.Amount,
 		Currency:  in.Currency,
 		SourceID:  src.ID,
+
+		IdempotencyKey: in.IdempotencyKey,
+		CallerID:       callerID(ctx),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &paymentv1.CreateSourceChargeResponse{
+	resp := &paymentv1.CreateSourceChargeResponse{
 		ChargeId:     ch.ID,
 		Status:       string(ch.Status),
 		AuthorizeUri: ch.AuthorizeURI, // ถ้าต้อง redirect จะมีค่านี้
-	}, nil
+	}
+	// promptpay: QR อยู่ใน source ของ charge
+	if ch.Source != nil && ch.Source.ScannableCode != nil && ch.Source.ScannableCode.Image != nil {
+		resp.QrImageUri = ch.Source.ScannableCode.Image.DownloadURI
+	}
+	if !ch.ExpiresAt.IsZero() {
+		resp.ExpiresAtIso = ch.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return resp, nil
 }
 
 // ---------- Get ----------
 func (s *Server) GetCharge(ctx context.Context, in *paymentv1.GetChargeRequest) (*paymentv1.GetChargeResponse, error) {
-	ch, err := s.svc.GetCharge(in.ChargeId)
+	ch, err := s.svc.GetCharge(ctx, in.ChargeId)
 	if err != nil {
 		return nil, err
 	}
@@ -79,3 +109,308 @@ func (s *Server) GetCharge(ctx context.Context, in *paymentv1.GetChargeRequest)
 		FailureMessage: fm,
 	}, nil
 }
+
+// ---------- Watch ----------
+func (s *Server) WatchCharge(in *paymentv1.WatchChargeRequest, stream paymentv1.PaymentService_WatchChargeServer) error {
+	ctx := stream.Context()
+	return s.svc.Watch(ctx, in.ChargeId, callerID(ctx), callerRole(ctx), func(p domain.Payment) error {
+		return stream.Send(&paymentv1.ChargeStatusEvent{
+			ChargeId:       p.ChargeID,
+			BookingId:      p.BookingID,
+			Status:         p.Status,
+			FailureCode:    p.FailureCode,
+			FailureMessage: p.FailureMessage,
+			UpdatedAtIso:   p.UpdatedAt.UTC().Format(time.RFC3339),
+		})
+	})
+}
+
+// ---------- Refund ----------
+func (s *Server) RefundCharge(ctx context.Context, in *paymentv1.RefundChargeRequest) (*paymentv1.RefundChargeResponse, error) {
+	rf, err := s.svc.Refund(ctx, service.RefundInput{
+		ChargeID: in.ChargeId,
+		Amount:   in.Amount,
+		Reason:   in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.RefundChargeResponse{
+		RefundId: rf.ID,
+		ChargeId: rf.ChargeID,
+		Amount:   rf.Amount,
+		Currency: rf.Currency,
+	}, nil
+}
+
+// ---------- Ledger ----------
+func (s *Server) ListPayments(ctx context.Context, in *paymentv1.ListPaymentsRequest) (*paymentv1.ListPaymentsResponse, error) {
+	list, total, err := s.svc.ListPayments(ctx, in.Page, in.PageSize, repository.ListFilter{
+		UserID:    in.UserId,
+		BookingID: in.BookingId,
+		Status:    in.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.ListPaymentsResponse{Total: total}
+	for i := range list {
+		resp.Payments = append(resp.Payments, toPB(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetPaymentsByBooking(ctx context.Context, in *paymentv1.GetPaymentsByBookingRequest) (*paymentv1.GetPaymentsByBookingResponse, error) {
+	list, err := s.svc.PaymentsByBooking(ctx, in.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.GetPaymentsByBookingResponse{}
+	for i := range list {
+		resp.Payments = append(resp.Payments, toPB(&list[i]))
+	}
+	return resp, nil
+}
+
+// ---------- Reconciliation ----------
+func (s *Server) GetReconciliationReport(ctx context.Context, in *paymentv1.GetReconciliationReportRequest) (*paymentv1.GetReconciliationReportResponse, error) {
+	run, err := s.svc.ReconciliationReport(ctx, in.RunId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.GetReconciliationReportResponse{
+		RunId:          run.ID,
+		WindowFromIso:  run.From.UTC().Format(time.RFC3339),
+		WindowToIso:    run.To.UTC().Format(time.RFC3339),
+		StartedAtIso:   run.StartedAt.UTC().Format(time.RFC3339),
+		FinishedAtIso:  run.FinishedAt.UTC().Format(time.RFC3339),
+		ChargesChecked: int32(run.ChargesChecked),
+		Error:          run.Error,
+	}
+	for _, it := range run.Items {
+		resp.Items = append(resp.Items, &paymentv1.ReconciliationItem{
+			ChargeId:  it.ChargeID,
+			BookingId: it.BookingID,
+			Kind:      it.Kind,
+			Detail:    it.Detail,
+			Action:    it.Action,
+		})
+	}
+	return resp, nil
+}
+
+// ---------- Payouts ----------
+func (s *Server) ListPayouts(ctx context.Context, in *paymentv1.ListPayoutsRequest) (*paymentv1.ListPayoutsResponse, error) {
+	list, total, err := s.payouts.ListPayouts(ctx, in.Page, in.PageSize, repository.PayoutFilter{
+		OwnerID: in.OwnerId,
+		Status:  in.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.ListPayoutsResponse{Total: total}
+	for i := range list {
+		resp.Payouts = append(resp.Payouts, payoutToPB(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetPayoutStatement(ctx context.Context, in *paymentv1.GetPayoutStatementRequest) (*paymentv1.GetPayoutStatementResponse, error) {
+	p, err := s.payouts.PayoutStatement(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.GetPayoutStatementResponse{Payout: payoutToPB(p)}
+	for _, l := range p.Lines {
+		resp.Lines = append(resp.Lines, &paymentv1.PayoutLine{
+			Kind:          l.Kind,
+			RefId:         l.RefID,
+			ChargeId:      l.ChargeID,
+			BookingId:     l.BookingID,
+			CourtId:       l.CourtID,
+			Amount:        l.Amount,
+			OccurredAtIso: l.OccurredAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) MarkPayoutPaid(ctx context.Context, in *paymentv1.MarkPayoutPaidRequest) (*paymentv1.MarkPayoutPaidResponse, error) {
+	p, err := s.payouts.MarkPaid(ctx, in.Id, in.Reference)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.MarkPayoutPaidResponse{Payout: payoutToPB(p)}, nil
+}
+
+func payoutToPB(p *domain.Payout) *paymentv1.Payout {
+	out := &paymentv1.Payout{
+		Id:                p.ID,
+		OwnerId:           p.OwnerID,
+		PeriodStartIso:    p.PeriodStart.UTC().Format(time.RFC3339),
+		PeriodEndIso:      p.PeriodEnd.UTC().Format(time.RFC3339),
+		Currency:          p.Currency,
+		Gross:             p.Gross,
+		Refunds:           p.Refunds,
+		CommissionPercent: p.CommissionPercent,
+		Commission:        p.Commission,
+		Net:               p.Net,
+		Status:            p.Status,
+		PaidRef:           p.PaidRef,
+		CreatedAtIso:      p.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if p.PaidAt != nil {
+		out.PaidAtIso = p.PaidAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+// ---------- Wallet ----------
+func (s *Server) GetWallet(ctx context.Context, in *paymentv1.GetWalletRequest) (*paymentv1.GetWalletResponse, error) {
+	w, err := s.svc.GetWallet(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.GetWalletResponse{Wallet: walletToPB(w)}, nil
+}
+
+func (s *Server) TopUp(ctx context.Context, in *paymentv1.TopUpRequest) (*paymentv1.TopUpResponse, error) {
+	ch, err := s.svc.TopUp(ctx, service.TopUpInput{
+		UserID:     in.UserId,
+		Amount:     in.Amount,
+		Currency:   in.Currency,
+		CardToken:  in.CardToken,
+		SourceType: in.SourceType,
+		ReturnURI:  in.ReturnUri,
+
+		IdempotencyKey: in.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.TopUpResponse{
+		ChargeId:     ch.ID,
+		Status:       string(ch.Status),
+		AuthorizeUri: ch.AuthorizeURI,
+	}
+	if ch.Source != nil && ch.Source.ScannableCode != nil && ch.Source.ScannableCode.Image != nil {
+		resp.QrImageUri = ch.Source.ScannableCode.Image.DownloadURI
+	}
+	if !ch.ExpiresAt.IsZero() {
+		resp.ExpiresAtIso = ch.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if w, err := s.svc.GetWallet(ctx, in.UserId); err == nil {
+		resp.Wallet = walletToPB(w)
+	}
+	return resp, nil
+}
+
+func (s *Server) ListWalletTransactions(ctx context.Context, in *paymentv1.ListWalletTransactionsRequest) (*paymentv1.ListWalletTransactionsResponse, error) {
+	list, total, err := s.svc.WalletTransactions(ctx, in.UserId, in.Page, in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.ListWalletTransactionsResponse{Total: total}
+	for i := range list {
+		resp.Transactions = append(resp.Transactions, walletTxToPB(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) PayWithWallet(ctx context.Context, in *paymentv1.PayWithWalletRequest) (*paymentv1.PayWithWalletResponse, error) {
+	wt, err := s.svc.PayWithWallet(ctx, in.UserId, in.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &paymentv1.PayWithWalletResponse{PaymentId: wt.ChargeID, Transaction: walletTxToPB(wt)}
+	if w, err := s.svc.GetWallet(ctx, in.UserId); err == nil {
+		resp.Wallet = walletToPB(w)
+	}
+	return resp, nil
+}
+
+// ---------- Receipt ----------
+func (s *Server) GetReceipt(ctx context.Context, in *paymentv1.GetReceiptRequest) (*paymentv1.GetReceiptResponse, error) {
+	r, err := s.receipts.Get(ctx, in.ChargeId, callerID(ctx), callerRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.GetReceiptResponse{
+		Receipt: &paymentv1.Receipt{
+			Number:      r.Number,
+			Kind:        r.Kind,
+			ChargeId:    r.ChargeID,
+			BookingId:   r.BookingID,
+			UserId:      r.UserID,
+			Venue:       r.Venue,
+			CourtNo:     r.CourtNo,
+			StartIso:    r.StartTime.UTC().Format(time.RFC3339),
+			EndIso:      r.EndTime.UTC().Format(time.RFC3339),
+			Amount:      r.Amount,
+			VatPercent:  r.VATPercent,
+			VatAmount:   r.VATAmount,
+			Currency:    r.Currency,
+			Method:      r.Method,
+			PaidAtIso:   r.PaidAt.UTC().Format(time.RFC3339),
+			IssuedAtIso: r.CreatedAt.UTC().Format(time.RFC3339),
+		},
+		Pdf: r.PDF,
+	}, nil
+}
+
+func first(ss []string) string {
+	if len(ss) > 0 {
+		return ss[0]
+	}
+	return ""
+}
+
+// callerID user id ของผู้เรียกจาก metadata ที่ gateway แนบมา (ว่าง = service ภายในเรียก)
+func callerID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md.Get("x-user-id"))
+}
+
+// callerRole role ของผู้เรียกจาก metadata ที่ gateway แนบมา
+func callerRole(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md.Get("x-user-role"))
+}
+
+func walletToPB(w *domain.Wallet) *paymentv1.Wallet {
+	out := &paymentv1.Wallet{UserId: w.UserID, Balance: w.Balance, Currency: w.Currency}
+	if !w.UpdatedAt.IsZero() {
+		out.UpdatedAtIso = w.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+func walletTxToPB(t *domain.WalletTransaction) *paymentv1.WalletTransaction {
+	return &paymentv1.WalletTransaction{
+		Id:           t.ID,
+		Kind:         t.Kind,
+		Amount:       t.Amount,
+		BalanceAfter: t.BalanceAfter,
+		Currency:     t.Currency,
+		ChargeId:     t.ChargeID,
+		BookingId:    t.BookingID,
+		CreatedAtIso: t.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toPB(p *domain.Payment) *paymentv1.Payment {
+	return &paymentv1.Payment{
+		ChargeId:       p.ChargeID,
+		BookingId:      p.BookingID,
+		UserId:         p.UserID,
+		Amount:         p.Amount,
+		Currency:       p.Currency,
+		Method:         p.Method,
+		Status:         p.Status,
+		FailureCode:    p.FailureCode,
+		FailureMessage: p.FailureMessage,
+		RefundedAmount: p.RefundedAmount,
+		CreatedAtIso:   p.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAtIso:   p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
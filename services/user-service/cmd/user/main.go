@@ -1,13 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
 	"github.com/kelseyhightower/envconfig"
 	"google.golang.org/grpc"
 
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/mq"
 	userv1 "github.com/you/badminton-booking/proto/user/v1"
+	"github.com/you/badminton-booking/services/user-service/internal/consumer"
 	"github.com/you/badminton-booking/services/user-service/internal/repository"
 	"github.com/you/badminton-booking/services/user-service/internal/service"
 	tgrpc "github.com/you/badminton-booking/services/user-service/internal/transport/grpc"
@@ -18,6 +22,11 @@ import (
 type Cfg struct {
 	UserGRPCAddr string `envconfig:"USER_GRPC_ADDR" default:":50051"`
 	PGUserDSN    string `envconfig:"PG_USER_DSN" required:"true"`
+
+	// user.role_changed จาก auth-service -> อัปเดต role ในโปรไฟล์
+	RabbitURL    string `envconfig:"RABBIT_URL" required:"true"`
+	UserExchange string `envconfig:"USER_EXCHANGE" default:"user.exchange"`
+	RoleQueue    string `envconfig:"USER_ROLE_QUEUE" default:"user.role.q"`
 }
 
 func main() {
@@ -34,6 +43,17 @@ func main() {
 
 	svc := service.NewUserSvc(repo)
 
+	// Consumer (ฟัง user.role_changed)
+	roleCons, err := mq.NewConsumer(cfg.RabbitURL, cfg.UserExchange, cfg.RoleQueue, []string{events.RKUserRoleChanged})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer roleCons.Close()
+	if err := consumer.NewRoleConsumer(svc, roleCons).Run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("[user] consumer started (user.role_changed)")
+
 	lis, err := net.Listen("tcp", cfg.UserGRPCAddr)
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,56 @@
+package consumer
+
+import (
+	"context"
+	"log"
+
+	"github.com/you/badminton-booking/pkg/events"
+	"github.com/you/badminton-booking/pkg/mq"
+	"github.com/you/badminton-booking/services/user-service/internal/service"
+)
+
+// RoleConsumer ทำ role ในโปรไฟล์ให้ตรงกับ auth-service (user.role_changed)
+// ส่งซ้ำได้: ตั้งค่าเดิมซ้ำไม่มีผล; outbox ส่งตามลำดับจึงได้ role ล่าสุดเสมอ
+type RoleConsumer struct {
+	svc  *service.UserSvc
+	cons *mq.Consumer
+}
+
+func NewRoleConsumer(svc *service.UserSvc, cons *mq.Consumer) *RoleConsumer {
+	return &RoleConsumer{svc: svc, cons: cons}
+}
+
+func (rc *RoleConsumer) Run(ctx context.Context) error {
+	msgs, err := rc.cons.Deliveries(ctx)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for d := range msgs {
+			if d.RoutingKey != events.RKUserRoleChanged {
+				_ = d.Ack(false)
+				continue
+			}
+			evt, err := events.Decode[events.UserRoleChanged](d.Body)
+			if err != nil {
+				log.Printf("[user-consumer] %v", err)
+				_ = d.Nack(false, false)
+				continue
+			}
+			if evt.Data.Email == "" || evt.Data.NewRole == "" {
+				log.Printf("[user-consumer] invalid event payload")
+				_ = d.Ack(false)
+				continue
+			}
+			ctx := evt.Context(ctx)
+			if err := rc.svc.ApplyRoleChange(ctx, evt.Data.Email, evt.Data.Name, evt.Data.NewRole); err != nil {
+				log.Printf("[user-consumer] role change for %s error: %v", evt.Data.Email, err)
+				_ = d.Nack(false, true)
+				continue
+			}
+			log.Printf("[user-consumer] %s: %s -> %s", evt.Data.Email, evt.Data.OldRole, evt.Data.NewRole)
+			_ = d.Ack(false)
+		}
+	}()
+	return nil
+}
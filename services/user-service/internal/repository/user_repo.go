@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/you/badminton-booking/services/user-service/internal/domain"
 )
@@ -50,6 +51,19 @@ func (r *UserRepo) ByEmail(ctx context.Context, email string) (*domain.User, err
 	return &u, nil
 }
 
+// SetRoleByEmail ตั้ง role ตาม auth-service; ยังไม่มีโปรไฟล์ของ email นี้ = สร้างใหม่
+func (r *UserRepo) SetRoleByEmail(ctx context.Context, email, name, role string) error {
+	db := r.db.WithContext(ctx)
+	res := db.Model(&domain.User{}).Where("email = ?", email).Update("role", role)
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "email"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(&domain.User{ID: uuid.NewString(), Email: email, Name: name, Role: role}).Error
+}
+
 func (r *UserRepo) UpdateFields(ctx context.Context, id string, fields map[string]any) (*domain.User, error) {
 	if err := r.db.WithContext(ctx).Model(&domain.User{}).Where("id = ?", id).Updates(fields).Error; err != nil {
 		return nil, err
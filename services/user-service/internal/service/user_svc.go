@@ -22,6 +22,14 @@ func (s *UserSvc) SyncFromAuth(ctx context.Context, email, name, role string) (*
 	return u, nil
 }
 
+// ApplyRoleChange ตาม user.role_changed ของ auth-service (จับคู่ด้วย email)
+func (s *UserSvc) ApplyRoleChange(ctx context.Context, email, name, role string) error {
+	if email == "" || role == "" {
+		return errors.New("missing email or role")
+	}
+	return s.repo.SetRoleByEmail(ctx, strings.ToLower(email), name, strings.ToUpper(role))
+}
+
 func (s *UserSvc) GetByID(ctx context.Context, id string) (*domain.User, error) {
 	return s.repo.ByID(ctx, id)
 }